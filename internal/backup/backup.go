@@ -0,0 +1,54 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+const timestampLayout = "20060102T150405Z"
+
+// State is what was known about the app in the Terraform state when it was destroyed. Secrets are left out.
+type State struct {
+	Manifest          string `json:"manifest"`
+	ClientID          string `json:"client_id,omitempty"`
+	OauthAuthorizeURL string `json:"oauth_authorize_url,omitempty"`
+}
+
+// Backup is the manifest of an app exported right before it is destroyed, so that it can be recreated.
+type Backup struct {
+	AppID      string        `json:"app_id"`
+	ExportedAt time.Time     `json:"exported_at"`
+	Manifest   *manifest.App `json:"manifest"`
+	State      State         `json:"state"`
+}
+
+// Write saves the backup as a JSON file named after the app ID and the time of the export into the directory, and
+// returns the path of the file.
+func Write(directory string, backup Backup) (string, error) {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return "", err
+	}
+
+	bytes, err := json.MarshalIndent(&backup, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(
+		directory,
+		fmt.Sprintf("%s-%s.json", backup.AppID, backup.ExportedAt.UTC().Format(timestampLayout)),
+	)
+
+	// An interrupted backup must never look like a complete one.
+	if err := common.WriteFileAtomic(path, bytes, 0o600); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
@@ -0,0 +1,94 @@
+package backup_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/backup"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+func TestWrite(t *testing.T) {
+	// The directory is created if missing, including its parents.
+	directory := filepath.Join(t.TempDir(), "backups", "slack")
+
+	app, err := manifest.Parse(`{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write"]}}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := backup.Backup{
+		AppID:      "A0123",
+		ExportedAt: time.Date(2026, 10, 17, 21, 4, 5, 0, time.FixedZone("JST", 9*60*60)),
+		Manifest:   app,
+		State: backup.State{
+			Manifest:          `{"display_information":{"name":"app"}}`,
+			ClientID:          "1234.5678",
+			OauthAuthorizeURL: "https://slack.com/oauth/v2/authorize?client_id=1234.5678&scope=chat:write&user_scope=",
+		},
+	}
+
+	path, err := backup.Write(directory, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The file is named after the time of the export in UTC.
+	if wantPath := filepath.Join(directory, "A0123-20261017T120405Z.json"); path != wantPath {
+		t.Errorf("the backup was written into %s, want %s", path, wantPath)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("the permission of the backup is %o, want %o", perm, 0o600)
+	}
+
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got backup.Backup
+	if err := json.Unmarshal(bytes, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if !got.ExportedAt.Equal(want.ExportedAt) {
+		t.Errorf("exported_at is %s, want %s", got.ExportedAt, want.ExportedAt)
+	}
+
+	got.ExportedAt = want.ExportedAt
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("the backup is %+v, want %+v", got, want)
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 {
+		t.Errorf("the directory has %d entries, want only the backup", len(entries))
+	}
+}
+
+func TestWriteUnwritableDirectory(t *testing.T) {
+	// A directory cannot be created under a regular file, even by root.
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := backup.Write(filepath.Join(file, "backups"), backup.Backup{AppID: "A0123"}); err == nil {
+		t.Error("the backup was written under a regular file without an error")
+	}
+}
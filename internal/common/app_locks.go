@@ -0,0 +1,34 @@
+package common
+
+import (
+	"sync"
+)
+
+// AppLocks serialises the changes made to the manifest of each app. Resources owning a part of a manifest update it
+// by exporting, modifying and writing back the whole manifest, which would lose concurrent changes otherwise. The locks
+// only work within the provider process, so separate Terraform runs must not change the same app at the same time.
+type AppLocks struct {
+	mutex sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// Lock waits until no one else holds the lock of the app, and returns the function to release it.
+func (l *AppLocks) Lock(appID string) func() {
+	l.mutex.Lock()
+
+	if l.locks == nil {
+		l.locks = map[string]*sync.Mutex{}
+	}
+
+	lock, ok := l.locks[appID]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.locks[appID] = lock
+	}
+
+	l.mutex.Unlock()
+
+	lock.Lock()
+
+	return lock.Unlock
+}
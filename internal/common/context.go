@@ -6,4 +6,14 @@ import (
 
 type ProviderContext struct {
 	SlackClient *slack.Client
+
+	// DestroyWarningScopeThreshold is the number of scopes above which destroying an app is warned about, or nil not
+	// to warn at all.
+	DestroyWarningScopeThreshold *int
+
+	// BackupDirectory is where manifests of apps are backed up before destroying them, or nil not to back up.
+	BackupDirectory *string
+
+	// AppLocks serialises the changes made by the resources owning parts of the same app.
+	AppLocks *AppLocks
 }
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+)
+
+// SlackErrorDetail describes an error returned from the Slack API along with a hint on how to resolve it.
+func SlackErrorDetail(err error) string {
+	var slackErr *slack.ErrorResponse
+	if !errors.As(err, &slackErr) {
+		return err.Error()
+	}
+
+	detail := fmt.Sprintf("Slack API responded with `%s` (HTTP %d).", slackErr.Error_, slackErr.HTTPStatus)
+
+	switch {
+	case errors.Is(err, slack.ErrTokenExpired):
+		return detail + " The app configuration token has expired. Provide a refresh token so that it can be rotated automatically."
+	case errors.Is(err, slack.ErrInvalidAuth):
+		return detail + " The app configuration token or refresh token is invalid. Generate new ones at https://api.slack.com/apps."
+	case errors.Is(err, slack.ErrMissingScope):
+		return detail + fmt.Sprintf(" The token is missing the scope `%s`, it only has `%s`.", slackErr.Needed, slackErr.Provided)
+	case errors.Is(err, slack.ErrRateLimited):
+		return detail + " The rate limit was still exceeded after retrying. Consider increasing `max_retries` or `max_backoff` of the provider."
+	case errors.Is(err, slack.ErrAppNotFound):
+		return detail + " The app does not exist, or the token is not allowed to manage it."
+	default:
+		return detail
+	}
+}
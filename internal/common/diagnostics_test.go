@@ -0,0 +1,70 @@
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+)
+
+func TestSlackErrorDetail(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want []string
+	}{
+		{
+			name: "expired token",
+			err:  &slack.ErrorResponse{Error_: "token_expired", HTTPStatus: http.StatusOK},
+			want: []string{"`token_expired` (HTTP 200)", "refresh token"},
+		},
+		{
+			name: "invalid token",
+			err:  &slack.ErrorResponse{Error_: "invalid_auth", HTTPStatus: http.StatusOK},
+			want: []string{"`invalid_auth`", "https://api.slack.com/apps"},
+		},
+		{
+			name: "missing scope",
+			err: &slack.ErrorResponse{
+				Error_:     "missing_scope",
+				Needed:     "app_configurations:write",
+				Provided:   "identify",
+				HTTPStatus: http.StatusOK,
+			},
+			want: []string{"`app_configurations:write`", "`identify`"},
+		},
+		{
+			name: "rate limited",
+			err:  &slack.ErrorResponse{Error_: "ratelimited", HTTPStatus: http.StatusTooManyRequests},
+			want: []string{"(HTTP 429)", "`max_retries`"},
+		},
+		{
+			name: "wrapped",
+			err:  fmt.Errorf("failed to rotate the stored token: %w", &slack.ErrorResponse{Error_: "app_not_found"}),
+			want: []string{"`app_not_found`", "does not exist"},
+		},
+		{
+			name: "other",
+			err:  errors.New("connection refused"),
+			want: []string{"connection refused"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				detail := common.SlackErrorDetail(tt.err)
+
+				for _, want := range tt.want {
+					if !strings.Contains(detail, want) {
+						t.Errorf("the detail %q does not contain %q", detail, want)
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,45 @@
+package common
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic writes the data into the file with the permission, through a temporary file in the same directory
+// that is renamed over the target, so that readers never see a partial file even if the write is interrupted.
+func WriteFileAtomic(path string, data []byte, perm fs.FileMode) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	tempPath := tempFile.Name()
+	defer func() {
+		_ = os.Remove(tempPath)
+	}()
+
+	if err := tempFile.Chmod(perm); err != nil {
+		_ = tempFile.Close()
+
+		return err
+	}
+
+	if _, err := tempFile.Write(data); err != nil {
+		_ = tempFile.Close()
+
+		return err
+	}
+
+	if err := tempFile.Sync(); err != nil {
+		_ = tempFile.Close()
+
+		return err
+	}
+
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, path)
+}
@@ -0,0 +1,57 @@
+package common_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	directory := t.TempDir()
+	path := filepath.Join(directory, "token.json")
+
+	if err := os.WriteFile(path, []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := common.WriteFileAtomic(path, []byte("new"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "new" {
+		t.Errorf("the file contains %q, want %q", data, "new")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("the permission of the file is %o, want %o", perm, 0o600)
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 {
+		t.Errorf("the directory has %d entries, want only the file", len(entries))
+	}
+}
+
+func TestWriteFileAtomicMissingDirectory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "token.json")
+
+	if err := common.WriteFileAtomic(path, []byte("new"), 0o600); err == nil {
+		t.Error("the file was written into a missing directory without an error")
+	}
+}
@@ -0,0 +1,107 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+// ManifestType is a string type holding an app manifest, compared semantically rather than byte by byte.
+type ManifestType struct {
+	basetypes.StringType
+}
+
+func (t ManifestType) Equal(o attr.Type) bool {
+	other, ok := o.(ManifestType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t ManifestType) String() string {
+	return "customtypes.ManifestType"
+}
+
+func (t ManifestType) ValueFromString(
+	_ context.Context,
+	in basetypes.StringValue,
+) (basetypes.StringValuable, diag.Diagnostics) {
+	return Manifest{StringValue: in}, nil
+}
+
+func (t ManifestType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t ManifestType) ValueType(_ context.Context) attr.Value {
+	return Manifest{}
+}
+
+type Manifest struct {
+	basetypes.StringValue
+}
+
+func NewManifestValue(value string) Manifest {
+	return Manifest{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v Manifest) Type(_ context.Context) attr.Type {
+	return ManifestType{}
+}
+
+func (v Manifest) Equal(o attr.Value) bool {
+	other, ok := o.(Manifest)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v Manifest) StringSemanticEquals(
+	_ context.Context,
+	newValuable basetypes.StringValuable,
+) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Manifest)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	equal, err := manifest.SemanticallyEqual(v.ValueString(), newValue.ValueString())
+	if err != nil {
+		// Invalid manifests are reported elsewhere, so fall back to the exact comparison here.
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	return equal, diags
+}
@@ -0,0 +1,87 @@
+package customtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/customtypes"
+)
+
+func TestManifestStringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    string
+		proposed basetypes.StringValuable
+		want     bool
+	}{
+		{
+			name:     "reordered keys",
+			prior:    `{"display_information":{"name":"app","description":"an app"}}`,
+			proposed: customtypes.NewManifestValue(`{"display_information":{"description":"an app","name":"app"}}`),
+			want:     true,
+		},
+		{
+			name:     "explicit false",
+			prior:    `{"display_information":{"name":"app"}}`,
+			proposed: customtypes.NewManifestValue(`{"display_information":{"name":"app"},"settings":{"socket_mode_enabled":false}}`),
+			want:     true,
+		},
+		{
+			name:     "YAML",
+			prior:    `{"display_information":{"name":"app"}}`,
+			proposed: customtypes.NewManifestValue("display_information:\n  name: app\n"),
+			want:     true,
+		},
+		{
+			name:     "unknown fields",
+			prior:    `{"display_information":{"name":"app"},"future":{"a":1,"b":2}}`,
+			proposed: customtypes.NewManifestValue(`{"future":{"b":2,"a":1},"display_information":{"name":"app"}}`),
+			want:     true,
+		},
+		{
+			name:     "unequal",
+			prior:    `{"display_information":{"name":"app"}}`,
+			proposed: customtypes.NewManifestValue(`{"display_information":{"name":"other"}}`),
+			want:     false,
+		},
+		{
+			name:     "invalid and identical",
+			prior:    `{"display_information":`,
+			proposed: customtypes.NewManifestValue(`{"display_information":`),
+			want:     true,
+		},
+		{
+			name:     "invalid and different",
+			prior:    `{"display_information":`,
+			proposed: customtypes.NewManifestValue(`{"display_information": `),
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, diags := customtypes.NewManifestValue(tt.prior).StringSemanticEquals(context.Background(), tt.proposed)
+				if diags.HasError() {
+					t.Fatal(diags)
+				}
+
+				if got != tt.want {
+					t.Errorf("StringSemanticEquals() = %t, want %t", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestManifestStringSemanticEqualsOtherType(t *testing.T) {
+	_, diags := customtypes.NewManifestValue(`{}`).StringSemanticEquals(
+		context.Background(),
+		basetypes.NewStringValue(`{}`),
+	)
+	if !diags.HasError() {
+		t.Error("a value of another type was compared without an error")
+	}
+}
@@ -0,0 +1,176 @@
+package generate
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/hclgen"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/tokenstore"
+)
+
+// Command is the name of the subcommand on the provider binary.
+const Command = "generate"
+
+type appIDs []string
+
+func (a *appIDs) String() string {
+	return strings.Join(*a, ",")
+}
+
+func (a *appIDs) Set(value string) error {
+	*a = append(*a, value)
+
+	return nil
+}
+
+// Run exports the manifests of the apps given in the arguments, and prints the configuration to adopt them into
+// Terraform: a slackapp_manifest data source, a slackapp_application resource and an import block for each app.
+// The Slack API is authenticated the same way as the provider, with the SLACK_* environment variables.
+func Run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) error {
+	var ids appIDs
+	var tokenStorePath string
+	var consumeRefreshToken bool
+
+	flags := flag.NewFlagSet(Command, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Var(&ids, "app-id", "ID of the app to generate the configuration for, which can be repeated")
+	flags.StringVar(&tokenStorePath, "token-store", "", "path to the token store file shared with the provider")
+	flags.BoolVar(
+		&consumeRefreshToken,
+		"consume-refresh-token",
+		false,
+		"rotate SLACK_REFRESH_TOKEN without -token-store, which leaves the provider unable to use it",
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		flags.Usage()
+
+		return errors.New("at least one -app-id must be given")
+	}
+
+	client, err := newClient(tokenStorePath, consumeRefreshToken)
+	if err != nil {
+		return err
+	}
+
+	names := map[string]bool{}
+	var errs []error
+
+	for _, id := range ids {
+		hcl, err := generate(ctx, client, id, names, stderr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", id, err))
+
+			continue
+		}
+
+		if len(names) > 1 {
+			hcl = "\n" + hcl
+		}
+
+		if _, err := io.WriteString(stdout, hcl); err != nil {
+			return err
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func newClient(tokenStorePath string, consumeRefreshToken bool) (*slack.Client, error) {
+	refreshToken := os.Getenv("SLACK_REFRESH_TOKEN")
+	appConfigurationToken := os.Getenv("SLACK_APP_CONFIGURATION_TOKEN")
+
+	// Refresh tokens can only be used once, so the provider would fail with the same one afterwards.
+	if refreshToken != "" && tokenStorePath == "" && !consumeRefreshToken {
+		return nil, errors.New(
+			"SLACK_REFRESH_TOKEN is rotated by this command, so give -token-store to save the new one, " +
+				"or -consume-refresh-token if it will not be used again",
+		)
+	}
+
+	var tokenStore slack.TokenStore
+	if tokenStorePath != "" {
+		tokenStore = tokenstore.NewFile(tokenStorePath)
+	}
+
+	var client *slack.Client
+	switch {
+	case refreshToken != "":
+		client = slack.NewClientFromRefreshToken(refreshToken)
+	case appConfigurationToken != "":
+		client = slack.NewClient(appConfigurationToken)
+	case tokenStore != nil:
+		client = slack.NewClientFromTokenStore(tokenStore)
+	default:
+		return nil, errors.New("either SLACK_APP_CONFIGURATION_TOKEN, SLACK_REFRESH_TOKEN or -token-store must be provided")
+	}
+
+	if tokenStore != nil {
+		client = client.WithTokenStore(tokenStore)
+	}
+
+	if baseURL := os.Getenv("SLACK_BASE_URL"); baseURL != "" {
+		client = client.WithBaseURL(baseURL)
+	}
+
+	return client, nil
+}
+
+func generate(
+	ctx context.Context,
+	client *slack.Client,
+	appID string,
+	names map[string]bool,
+	stderr io.Writer,
+) (string, error) {
+	response, err := client.AppsManifestExport(ctx, slack.AppsManifestExportRequest{AppID: appID})
+	if err != nil {
+		return "", err
+	}
+
+	if response.Manifest == nil {
+		return "", errors.New("apps.manifest.export returned ok but no manifest payload")
+	}
+
+	// Apps with the same name get the app ID appended to keep the addresses unique.
+	name := hclgen.Identifier(response.Manifest.DisplayInformation.Name, "app")
+	if names[name] {
+		name = hclgen.Identifier(name+" "+appID, "app")
+	}
+
+	dataSource, diags := datasources.GenerateManifestHCL(ctx, name, *response.Manifest)
+	if diags.HasError() {
+		return "", fmt.Errorf("%s: %s", diags[0].Summary(), diags[0].Detail())
+	}
+
+	// The name is taken only by apps that are written, so that a skipped app does not rename the next one.
+	names[name] = true
+
+	for _, d := range diags.Warnings() {
+		fmt.Fprintf(stderr, "warning: %s: %s %s\n", appID, d.Summary(), d.Detail())
+	}
+
+	var b strings.Builder
+
+	b.WriteString(dataSource)
+	fmt.Fprintf(&b, "\nresource \"slackapp_application\" %s {\n", hclgen.Quote(name))
+	fmt.Fprintf(&b, "  manifest = data.slackapp_manifest.%s.json\n", name)
+	b.WriteString("}\n")
+	b.WriteString("\nimport {\n")
+	fmt.Fprintf(&b, "  to = slackapp_application.%s\n", name)
+	fmt.Fprintf(&b, "  id = %s\n", hclgen.Quote(appID))
+	b.WriteString("}\n")
+
+	return b.String(), nil
+}
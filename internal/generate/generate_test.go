@@ -0,0 +1,183 @@
+package generate_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/generate"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/slacktest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/tokenstore"
+)
+
+const (
+	testToken        = "xoxe.xoxp-test"
+	testRefreshToken = "xoxe-test"
+)
+
+// newSlackServer starts a fake Slack API, and points the command to it with an app configuration token.
+func newSlackServer(t *testing.T) *slacktest.Server {
+	t.Helper()
+
+	server := slacktest.NewServer()
+	t.Cleanup(server.Close)
+
+	server.AddToken(testToken, time.Now().Add(time.Hour))
+
+	t.Setenv("SLACK_BASE_URL", server.BaseURL())
+	t.Setenv("SLACK_APP_CONFIGURATION_TOKEN", testToken)
+	t.Setenv("SLACK_REFRESH_TOKEN", "")
+
+	return server
+}
+
+func createApp(t *testing.T, server *slacktest.Server, manifestJSON string) string {
+	t.Helper()
+
+	appID, err := server.CreateApp(manifestJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return appID
+}
+
+func TestRun(t *testing.T) {
+	server := newSlackServer(t)
+
+	first := createApp(t, server, `{"display_information":{"name":"My App"}}`)
+	second := createApp(t, server, `{"display_information":{"name":"My App"}}`)
+
+	var stdout, stderr bytes.Buffer
+
+	err := generate.Run(context.Background(), []string{"-app-id", first, "-app-id", second}, &stdout, &stderr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Apps with the same name get the app ID appended to their addresses.
+	secondName := "my_app_" + strings.ToLower(second)
+
+	for _, want := range []string{
+		`data "slackapp_manifest" "my_app" {`,
+		`resource "slackapp_application" "my_app" {`,
+		"  manifest = data.slackapp_manifest.my_app.json\n",
+		"  to = slackapp_application.my_app\n  id = \"" + first + "\"\n",
+		`resource "slackapp_application" "` + secondName + `" {`,
+		"  to = slackapp_application." + secondName + "\n  id = \"" + second + "\"\n",
+	} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("the output does not contain %q:\n%s", want, stdout.String())
+		}
+	}
+
+	if stderr.Len() > 0 {
+		t.Errorf("unexpected warnings:\n%s", stderr.String())
+	}
+}
+
+func TestRunReportsEachFailedApp(t *testing.T) {
+	server := newSlackServer(t)
+
+	appID := createApp(t, server, `{"display_information":{"name":"found"}}`)
+
+	var stdout, stderr bytes.Buffer
+
+	err := generate.Run(context.Background(), []string{"-app-id", "A0MISSING", "-app-id", appID}, &stdout, &stderr)
+	if err == nil || !strings.Contains(err.Error(), "A0MISSING") {
+		t.Errorf("the error is %v, want one about A0MISSING", err)
+	}
+
+	if !strings.Contains(stdout.String(), `id = "`+appID+`"`) {
+		t.Errorf("the app found was not generated:\n%s", stdout.String())
+	}
+}
+
+func TestRunWithoutAppID(t *testing.T) {
+	newSlackServer(t)
+
+	var stdout, stderr bytes.Buffer
+
+	if err := generate.Run(context.Background(), nil, &stdout, &stderr); err == nil {
+		t.Error("the command ran without -app-id")
+	}
+}
+
+func TestRunRefreshToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		tokenStore bool
+		wantErr    string
+		wantRotate int
+	}{
+		{
+			name:       "without a token store",
+			wantErr:    "-consume-refresh-token",
+			wantRotate: 0,
+		},
+		{
+			name:       "consuming the refresh token",
+			args:       []string{"-consume-refresh-token"},
+			wantRotate: 1,
+		},
+		{
+			name:       "with a token store",
+			tokenStore: true,
+			wantRotate: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				server := newSlackServer(t)
+				server.AddRefreshToken(testRefreshToken)
+
+				t.Setenv("SLACK_APP_CONFIGURATION_TOKEN", "")
+				t.Setenv("SLACK_REFRESH_TOKEN", testRefreshToken)
+
+				appID := createApp(t, server, `{"display_information":{"name":"app"}}`)
+
+				args := append([]string{"-app-id", appID}, tt.args...)
+
+				tokenStorePath := filepath.Join(t.TempDir(), "token.json")
+				if tt.tokenStore {
+					args = append(args, "-token-store", tokenStorePath)
+				}
+
+				var stdout, stderr bytes.Buffer
+
+				err := generate.Run(context.Background(), args, &stdout, &stderr)
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Errorf("the error is %v, want one mentioning %s", err, tt.wantErr)
+					}
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				if got := server.Calls("tooling.tokens.rotate"); got != tt.wantRotate {
+					t.Errorf("tooling.tokens.rotate was called %d times, want %d", got, tt.wantRotate)
+				}
+
+				if !tt.tokenStore {
+					return
+				}
+
+				// The rotated refresh token must be saved for the provider.
+				stored, err := tokenstore.NewFile(tokenStorePath).Load(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if stored == nil || stored.RefreshToken == "" || stored.RefreshToken == testRefreshToken {
+					t.Errorf("the token store holds %+v, want the rotated refresh token", stored)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,293 @@
+package hclgen
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const indentation = "  "
+
+// DataSource renders the value of a data source as a `data` block in the Terraform language. Computed-only
+// attributes and null values are left out, as they cannot be configured.
+func DataSource(typeName string, name string, s schema.Schema, value tftypes.Value) (string, error) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "data %s %s {\n", Quote(typeName), Quote(name))
+
+	if err := writeBody(&b, 1, s.Attributes, s.Blocks, value); err != nil {
+		return "", err
+	}
+
+	b.WriteString("}\n")
+
+	return b.String(), nil
+}
+
+// Identifier converts the text into a name usable for Terraform resources and data sources, e.g. `Example App`
+// into `example_app`. It returns the fallback if no letters or digits are left.
+func Identifier(text string, fallback string) string {
+	var b strings.Builder
+
+	separate := false
+	for _, r := range strings.ToLower(text) {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			separate = b.Len() > 0
+
+			continue
+		}
+
+		if separate {
+			b.WriteRune('_')
+			separate = false
+		}
+
+		b.WriteRune(r)
+	}
+
+	identifier := b.String()
+	if identifier == "" {
+		return fallback
+	}
+
+	if unicode.IsDigit(rune(identifier[0])) {
+		identifier = "_" + identifier
+	}
+
+	return identifier
+}
+
+func writeBody(
+	b *strings.Builder,
+	depth int,
+	attributes map[string]schema.Attribute,
+	blocks map[string]schema.Block,
+	value tftypes.Value,
+) error {
+	var fields map[string]tftypes.Value
+	if err := value.As(&fields); err != nil {
+		return err
+	}
+
+	type line struct {
+		name, value string
+	}
+
+	var lines []line
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+		if !attribute.IsOptional() && !attribute.IsRequired() {
+			continue
+		}
+
+		field := fields[name]
+		if field.IsNull() {
+			continue
+		}
+
+		expression, err := writeExpression(depth, field)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		lines = append(lines, line{name, expression})
+	}
+
+	// Align the equals signs in a run of single-line attributes, as `terraform fmt` does.
+	for start := 0; start < len(lines); {
+		end, width := start, 0
+		for end < len(lines) {
+			width = max(width, len(lines[end].name))
+			end++
+
+			if strings.Contains(lines[end-1].value, "\n") {
+				break
+			}
+		}
+
+		for _, l := range lines[start:end] {
+			fmt.Fprintf(b, "%s%-*s = %s\n", strings.Repeat(indentation, depth), width, l.name, l.value)
+		}
+
+		start = end
+	}
+
+	for _, name := range sortedKeys(blocks) {
+		field := fields[name]
+		if field.IsNull() {
+			continue
+		}
+
+		var elements []tftypes.Value
+		switch blocks[name].(type) {
+		case *schema.SingleNestedBlock:
+			elements = []tftypes.Value{field}
+		default:
+			if err := field.As(&elements); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+
+		for _, element := range elements {
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), "{\n") {
+				b.WriteString("\n")
+			}
+
+			fmt.Fprintf(b, "%s%s {\n", strings.Repeat(indentation, depth), name)
+
+			nestedAttributes, nestedBlocks, err := nestedBlockObject(blocks[name])
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			if err := writeBody(b, depth+1, nestedAttributes, nestedBlocks, element); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+
+			fmt.Fprintf(b, "%s}\n", strings.Repeat(indentation, depth))
+		}
+	}
+
+	return nil
+}
+
+func nestedBlockObject(block schema.Block) (map[string]schema.Attribute, map[string]schema.Block, error) {
+	switch b := block.(type) {
+	case *schema.SingleNestedBlock:
+		return b.Attributes, b.Blocks, nil
+	case *schema.ListNestedBlock:
+		return b.NestedObject.Attributes, b.NestedObject.Blocks, nil
+	case *schema.SetNestedBlock:
+		return b.NestedObject.Attributes, b.NestedObject.Blocks, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported block type %T", block)
+	}
+}
+
+func writeExpression(depth int, value tftypes.Value) (string, error) {
+	if value.IsNull() {
+		return "null", nil
+	}
+
+	if !value.IsKnown() {
+		return "", fmt.Errorf("value is unknown")
+	}
+
+	switch t := value.Type(); {
+	case t.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return "", err
+		}
+
+		return Quote(s), nil
+	case t.Is(tftypes.Bool):
+		var v bool
+		if err := value.As(&v); err != nil {
+			return "", err
+		}
+
+		return fmt.Sprint(v), nil
+	case t.Is(tftypes.Number):
+		var v big.Float
+		if err := value.As(&v); err != nil {
+			return "", err
+		}
+
+		return v.Text('f', -1), nil
+	case t.Is(tftypes.List{}), t.Is(tftypes.Set{}), t.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+
+		expressions := make([]string, 0, len(elements))
+		for _, element := range elements {
+			expression, err := writeExpression(depth+1, element)
+			if err != nil {
+				return "", err
+			}
+
+			expressions = append(expressions, expression)
+		}
+
+		return "[" + strings.Join(expressions, ", ") + "]", nil
+	case t.Is(tftypes.Map{}), t.Is(tftypes.Object{}):
+		var fields map[string]tftypes.Value
+		if err := value.As(&fields); err != nil {
+			return "", err
+		}
+
+		if len(fields) == 0 {
+			return "{}", nil
+		}
+
+		var b strings.Builder
+
+		b.WriteString("{\n")
+
+		for _, key := range sortedKeys(fields) {
+			expression, err := writeExpression(depth+1, fields[key])
+			if err != nil {
+				return "", err
+			}
+
+			fmt.Fprintf(&b, "%s%s = %s\n", strings.Repeat(indentation, depth+1), Quote(key), expression)
+		}
+
+		b.WriteString(strings.Repeat(indentation, depth) + "}")
+
+		return b.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+// Quote renders the string as a quoted template, escaping the template sequences so that it is taken literally.
+func Quote(s string) string {
+	var b strings.Builder
+
+	b.WriteRune('"')
+
+	for i, r := range s {
+		switch {
+		case r == '"':
+			b.WriteString(`\"`)
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		case unicode.IsControl(r):
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteRune('"')
+
+	return b.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,38 @@
+package myvalidator
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type jsonObjectValidator struct{}
+
+func JSONObject() validator.String {
+	return jsonObjectValidator{}
+}
+
+func (v jsonObjectValidator) Description(context.Context) string {
+	return "string must be a JSON-encoded object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &object); err != nil {
+		resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			req.Path,
+			"Invalid JSON object",
+			"The value must be a JSON-encoded object, e.g. using jsonencode(): "+err.Error(),
+		))
+	}
+}
@@ -0,0 +1,61 @@
+package myvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type uniqueAttributeValidator struct {
+	attributeName string
+}
+
+// UniqueAttribute validates that no two objects in the list have the same value for the attribute.
+func UniqueAttribute(attributeName string) validator.List {
+	return uniqueAttributeValidator{
+		attributeName: attributeName,
+	}
+}
+
+func (v uniqueAttributeValidator) Description(context.Context) string {
+	return fmt.Sprintf("each element must have a unique `%s`", v.attributeName)
+}
+
+func (v uniqueAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueAttributeValidator) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[string]int{}
+
+	for i, element := range req.ConfigValue.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		value, ok := object.Attributes()[v.attributeName].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if first, ok := seen[value.ValueString()]; ok {
+			resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+				req.Path.AtListIndex(i).AtName(v.attributeName),
+				fmt.Sprintf("Duplicate %s", v.attributeName),
+				fmt.Sprintf("%q is already used by the element at index %d.", value.ValueString(), first),
+			))
+
+			continue
+		}
+
+		seen[value.ValueString()] = i
+	}
+}
@@ -0,0 +1,280 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/slacktest"
+)
+
+// createApp creates an app in Slack outside of Terraform, to which the slices are added.
+func createApp(t *testing.T, server *slacktest.Server, manifestJSON string) string {
+	t.Helper()
+
+	appID, err := server.CreateApp(manifestJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return appID
+}
+
+// checkAppParts checks that the app has exactly the slash commands, shortcuts, bot scopes and bot events, in any order
+// as the resources adding them are applied in parallel.
+func checkAppParts(
+	server *slacktest.Server,
+	appID string,
+	commands, callbackIDs, scopes, events []string,
+) func(*terraform.State) error {
+	return func(*terraform.State) error {
+		manifestJSON, ok := server.Manifest(appID)
+		if !ok {
+			return fmt.Errorf("the app %s does not exist", appID)
+		}
+
+		app, err := manifest.Parse(manifestJSON)
+		if err != nil {
+			return err
+		}
+
+		var gotCommands, gotCallbackIDs []string
+		if app.Features != nil {
+			for _, command := range app.Features.SlashCommands {
+				gotCommands = append(gotCommands, command.Command)
+			}
+
+			for _, shortcut := range app.Features.Shortcuts {
+				gotCallbackIDs = append(gotCallbackIDs, shortcut.CallbackID)
+			}
+		}
+
+		for _, c := range []struct {
+			name      string
+			got, want []string
+		}{
+			{"slash commands", gotCommands, commands},
+			{"shortcuts", gotCallbackIDs, callbackIDs},
+			{"bot scopes", app.BotScopes(), scopes},
+			{"bot events", app.BotEvents(), events},
+		} {
+			got := slices.Clone(c.got)
+			slices.Sort(got)
+
+			if !slices.Equal(got, c.want) {
+				return fmt.Errorf("the app has the %s %v, want %v: %s", c.name, got, c.want, manifestJSON)
+			}
+		}
+
+		return nil
+	}
+}
+
+func slashCommandConfig(server *slacktest.Server, appID string, description string) string {
+	return providerConfig(
+		server, fmt.Sprintf(
+			`
+resource "slackapp_slash_command" "test" {
+  app_id      = %q
+  command     = "/hello"
+  description = %q
+}
+`, appID, description,
+		),
+	)
+}
+
+func TestAccSlashCommandLifecycle(t *testing.T) {
+	server := newSlackServer(t)
+
+	existing := `{"command":"/existing","description":"Exists."}`
+	appID := createApp(t, server, `{"display_information":{"name":"app"},"features":{"slash_commands":[`+existing+`]}}`)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			// Only the slash command is removed, leaving the rest of the app as it was.
+			CheckDestroy: checkAppManifest(
+				server,
+				appID,
+				`{"display_information":{"name":"app"},"features":{"slash_commands":[`+existing+`]}}`,
+			),
+			Steps: []resource.TestStep{
+				{
+					Config: slashCommandConfig(server, appID, "Says hello."),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("slackapp_slash_command.test", "id", appID+":/hello"),
+						checkAppManifest(
+							server,
+							appID,
+							`{"display_information":{"name":"app"},"features":{"slash_commands":[`+existing+
+								`,{"command":"/hello","description":"Says hello."}]}}`,
+						),
+					),
+				},
+				{
+					Config: slashCommandConfig(server, appID, "Says hello again."),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("slackapp_slash_command.test", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: checkAppManifest(
+						server,
+						appID,
+						`{"display_information":{"name":"app"},"features":{"slash_commands":[`+existing+
+							`,{"command":"/hello","description":"Says hello again."}]}}`,
+					),
+				},
+				{
+					ResourceName:      "slackapp_slash_command.test",
+					ImportState:       true,
+					ImportStateId:     appID + ":/hello",
+					ImportStateVerify: true,
+				},
+			},
+		},
+	)
+}
+
+func TestAccSlashCommandAlreadyExists(t *testing.T) {
+	server := newSlackServer(t)
+
+	appID := createApp(
+		t,
+		server,
+		`{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/hello","description":"Exists."}]}}`,
+	)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      slashCommandConfig(server, appID, "Says hello."),
+					ExpectError: regexp.MustCompile("the slash command `/hello` already exists, import it instead"),
+				},
+			},
+		},
+	)
+}
+
+func TestAccAppSlicesConcurrently(t *testing.T) {
+	server := newSlackServer(t)
+
+	appID := createApp(t, server, `{"display_information":{"name":"app"}}`)
+
+	// Terraform applies the resources in parallel, and the changes to the same app must not overwrite each other.
+	config := providerConfig(
+		server, fmt.Sprintf(
+			`
+locals {
+  app_id = %q
+  scopes = ["chat:write", "commands", "users:read"]
+  events = ["app_mention", "message.im"]
+}
+
+resource "slackapp_slash_command" "test" {
+  count = 4
+
+  app_id      = local.app_id
+  command     = "/command${count.index}"
+  description = "Command ${count.index}."
+}
+
+resource "slackapp_shortcut" "test" {
+  count = 2
+
+  app_id      = local.app_id
+  callback_id = "shortcut${count.index}"
+  name        = "Shortcut ${count.index}"
+  description = "Shortcut ${count.index}."
+  type        = "global"
+}
+
+resource "slackapp_scope" "test" {
+  count = length(local.scopes)
+
+  app_id = local.app_id
+  scope  = local.scopes[count.index]
+}
+
+resource "slackapp_event_subscription" "test" {
+  count = length(local.events)
+
+  app_id = local.app_id
+  event  = local.events[count.index]
+}
+`, appID,
+		),
+	)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			CheckDestroy:             checkAppManifest(server, appID, `{"display_information":{"name":"app"}}`),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: checkAppParts(
+						server,
+						appID,
+						[]string{"/command0", "/command1", "/command2", "/command3"},
+						[]string{"shortcut0", "shortcut1"},
+						[]string{"chat:write", "commands", "users:read"},
+						[]string{"app_mention", "message.im"},
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestAccAppSliceOfApplication(t *testing.T) {
+	server := newSlackServer(t)
+
+	config := providerConfig(
+		server, `
+resource "slackapp_application" "test" {
+  manifest = jsonencode({ display_information = { name = "app" } })
+}
+
+resource "slackapp_slash_command" "test" {
+  app_id      = slackapp_application.test.id
+  command     = "/hello"
+  description = "Says hello."
+}
+`,
+	)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			CheckDestroy:             checkNoApps(server),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					// The application owns the whole manifest, so it plans to remove the slash command added to it.
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PostApplyPostRefresh: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(applicationAddress, plancheck.ResourceActionUpdate),
+							plancheck.ExpectResourceAction("slackapp_slash_command.test", plancheck.ResourceActionNoop),
+						},
+					},
+					ExpectNonEmptyPlan: true,
+					Check: checkManifest(
+						server,
+						applicationAddress,
+						`{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/hello","description":"Says hello."}]}}`,
+					),
+				},
+			},
+		},
+	)
+}
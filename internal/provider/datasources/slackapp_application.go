@@ -0,0 +1,136 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+type SlackApp struct {
+	ctx *common.ProviderContext
+}
+
+func NewSlackApp() datasource.DataSource {
+	return &SlackApp{}
+}
+
+func (d *SlackApp) Metadata(
+	_ context.Context,
+	_ datasource.MetadataRequest,
+	response *datasource.MetadataResponse,
+) {
+	response.TypeName = "slackapp_application"
+}
+
+func (d *SlackApp) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	attributes, err := decodedManifestAttributes(
+		map[string]schema.Attribute{
+			"app_id": &schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the app.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	)
+	if err != nil {
+		response.Diagnostics.AddError("Failed to build the schema of the manifest.", err.Error())
+
+		return
+	}
+
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Reads the manifest of an existing Slack App, which may be managed outside of this configuration.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *SlackApp) Configure(
+	_ context.Context,
+	request datasource.ConfigureRequest,
+	response *datasource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerContext, ok := request.ProviderData.(*common.ProviderContext)
+	if !ok {
+		response.Diagnostics.AddError(
+			"The ctx did not configured properly.",
+			"request.ProviderData.(type) != *ctx.ConfiguredProvider",
+		)
+
+		return
+	}
+
+	d.ctx = providerContext
+}
+
+func (d *SlackApp) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var appID types.String
+
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("app_id"), &appID)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	apiResponse, err := d.ctx.SlackClient.AppsManifestExport(
+		ctx, slack.AppsManifestExportRequest{
+			AppID: appID.ValueString(),
+		},
+	)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root("app_id"),
+			"Failed to read the Slack App using API.",
+			common.SlackErrorDetail(err),
+		)
+
+		return
+	}
+
+	if apiResponse.Manifest == nil {
+		response.Diagnostics.AddError("Slack API returned empty manifest.", "apps.manifest.export returned ok but no manifest payload")
+
+		return
+	}
+
+	json, err := apiResponse.Manifest.ToJsonString()
+	if err != nil {
+		response.Diagnostics.AddError("Failed to marshal the manifest into JSON.", err.Error())
+
+		return
+	}
+
+	yaml, err := manifest.JSONToYAML(json)
+	if err != nil {
+		response.Diagnostics.AddError("Failed to marshal the manifest into YAML.", err.Error())
+
+		return
+	}
+
+	data := decodeManifest(*apiResponse.Manifest, json, yaml)
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("app_id"), appID)...)
+	response.Diagnostics.Append(data.setState(ctx, &response.State)...)
+}
@@ -2,12 +2,21 @@ package datasources
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/hclgen"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources/slackappmanifest"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/typeconv"
@@ -20,9 +29,17 @@ type SlackAppManifestModel struct {
 	Settings           *slackappmanifest.Settings           `tfsdk:"settings"`
 	Features           *slackappmanifest.Features           `tfsdk:"features"`
 	OauthConfig        *slackappmanifest.OauthConfig        `tfsdk:"oauth_config"`
+	Functions          []slackappmanifest.Function          `tfsdk:"function"`
+	Workflows          []slackappmanifest.Workflow          `tfsdk:"workflow"`
+	Datastores         []slackappmanifest.Datastore         `tfsdk:"datastore"`
+	Types              []slackappmanifest.CustomType        `tfsdk:"type"`
+
+	// Arguments
+	OutgoingDomains types.Set `tfsdk:"outgoing_domains"`
 
 	// Attributes
 	Json types.String `tfsdk:"json"`
+	Yaml types.String `tfsdk:"yaml"`
 }
 
 func (m *SlackAppManifestModel) Read() manifest.App {
@@ -32,9 +49,113 @@ func (m *SlackAppManifestModel) Read() manifest.App {
 		Settings:           typeconv.MapOptionModel[manifest.Settings](m.Settings),
 		Features:           typeconv.MapOptionModel[manifest.Features](m.Features),
 		OauthConfig:        typeconv.MapOptionModel[manifest.OauthConfig](m.OauthConfig),
+		Functions:          slackappmanifest.ReadFunctions(m.Functions),
+		Workflows:          slackappmanifest.ReadWorkflows(m.Workflows),
+		Datastores:         slackappmanifest.ReadDatastores(m.Datastores),
+		Types:              slackappmanifest.ReadCustomTypes(m.Types),
+		OutgoingDomains:    typeconv.MustStringSetAsArray(&m.OutgoingDomains),
+	}
+}
+
+// NewSlackAppManifestModel returns the configuration of the data source that generates the manifest.
+func NewSlackAppManifestModel(app manifest.App) SlackAppManifestModel {
+	displayInformation := slackappmanifest.NewDisplayInformation(app.DisplayInformation)
+
+	return SlackAppManifestModel{
+		Metadata:           typeconv.MapOption(app.Metadata, slackappmanifest.NewMetadata),
+		DisplayInformation: &displayInformation,
+		Settings:           typeconv.MapOption(app.Settings, slackappmanifest.NewSettings),
+		Features:           typeconv.MapOption(app.Features, slackappmanifest.NewFeatures),
+		OauthConfig:        typeconv.MapOption(app.OauthConfig, slackappmanifest.NewOauthConfig),
+		Functions:          slackappmanifest.NewFunctions(app.Functions),
+		Workflows:          slackappmanifest.NewWorkflows(app.Workflows),
+		Datastores:         slackappmanifest.NewDatastores(app.Datastores),
+		Types:              slackappmanifest.NewCustomTypes(app.Types),
+		OutgoingDomains:    typeconv.StringArrayAsSet(app.OutgoingDomains),
+		Json:               types.StringNull(),
+		Yaml:               types.StringNull(),
 	}
 }
 
+// GenerateManifestHCL renders a slackapp_manifest data source named as given that generates the manifest. The fields
+// that the data source cannot generate are listed in a comment above it, and in a warning.
+func GenerateManifestHCL(ctx context.Context, name string, app manifest.App) (string, diag.Diagnostics) {
+	var schemaResponse datasource.SchemaResponse
+
+	(&SlackAppManifest{}).Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+	state := tfsdk.State{Schema: schemaResponse.Schema}
+	model := NewSlackAppManifestModel(app)
+
+	diags := state.Set(ctx, &model)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	hcl, err := hclgen.DataSource("slackapp_manifest", name, schemaResponse.Schema, state.Raw)
+	if err != nil {
+		diags.AddError("Failed to generate the configuration of the manifest.", err.Error())
+
+		return "", diags
+	}
+
+	dropped, err := droppedFields(app, model)
+	if err != nil {
+		diags.AddError("Failed to compare the generated manifest with the original.", err.Error())
+
+		return "", diags
+	}
+
+	if len(dropped) > 0 {
+		diags.AddWarning(
+			"Some fields of the manifest cannot be generated.",
+			fmt.Sprintf(
+				"The following fields are not supported by `slackapp_manifest`, and would be removed from the app "+
+					"by applying the generated configuration:\n%s",
+				strings.Join(dropped, "\n"),
+			),
+		)
+
+		var comment strings.Builder
+
+		comment.WriteString("# The following fields of the manifest are not supported by slackapp_manifest:\n")
+		for _, pointer := range dropped {
+			fmt.Fprintf(&comment, "#   %s\n", pointer)
+		}
+
+		hcl = comment.String() + hcl
+	}
+
+	return hcl, diags
+}
+
+// droppedFields returns the JSON pointers of the fields of the manifest that the model does not reproduce.
+func droppedFields(app manifest.App, model SlackAppManifestModel) ([]string, error) {
+	original, err := app.ToJsonString()
+	if err != nil {
+		return nil, err
+	}
+
+	generatedApp := model.Read()
+
+	generated, err := generatedApp.ToJsonString()
+	if err != nil {
+		return nil, err
+	}
+
+	differences, err := manifest.Diff(original, generated)
+	if err != nil {
+		return nil, err
+	}
+
+	pointers := make([]string, 0, len(differences))
+	for _, d := range differences {
+		pointers = append(pointers, d.Pointer)
+	}
+
+	return pointers, nil
+}
+
 type SlackAppManifest struct {
 	ctx *common.ProviderContext
 }
@@ -58,22 +179,63 @@ func (d *SlackAppManifest) Schema(
 ) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: "Represents manifest of the Slack App.",
-		Blocks: map[string]schema.Block{
-			"metadata":            (*slackappmanifest.Metadata)(nil).Schema(),
-			"display_information": (*slackappmanifest.DisplayInformation)(nil).Schema(),
-			"settings":            (*slackappmanifest.Settings)(nil).Schema(),
-			"features":            (*slackappmanifest.Features)(nil).Schema(),
-			"oauth_config":        (*slackappmanifest.OauthConfig)(nil).Schema(),
-		},
-		Attributes: map[string]schema.Attribute{
-			"json": &schema.StringAttribute{
-				MarkdownDescription: "JSON representation of the manifest.",
-				Computed:            true,
+		Blocks:              manifestBlocks(),
+		Attributes: mergeAttributes(
+			manifestArguments(),
+			map[string]schema.Attribute{
+				"json": &schema.StringAttribute{
+					MarkdownDescription: "JSON representation of the manifest.",
+					Computed:            true,
+				},
+				"yaml": &schema.StringAttribute{
+					MarkdownDescription: "YAML representation of the manifest, as used by the app configuration pages and the Slack CLI.",
+					Computed:            true,
+				},
+			},
+		),
+	}
+}
+
+// manifestBlocks returns the blocks that build up the manifest, shared with the data sources decoding manifests.
+func manifestBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"metadata":            (*slackappmanifest.Metadata)(nil).Schema(),
+		"display_information": (*slackappmanifest.DisplayInformation)(nil).Schema(),
+		"settings":            (*slackappmanifest.Settings)(nil).Schema(),
+		"features":            (*slackappmanifest.Features)(nil).Schema(),
+		"oauth_config":        (*slackappmanifest.OauthConfig)(nil).Schema(),
+		"function":            (*slackappmanifest.Function)(nil).Schema(),
+		"workflow":            (*slackappmanifest.Workflow)(nil).Schema(),
+		"datastore":           (*slackappmanifest.Datastore)(nil).Schema(),
+		"type":                (*slackappmanifest.CustomType)(nil).Schema(),
+	}
+}
+
+// manifestArguments returns the top-level arguments of the manifest, shared with the data sources decoding manifests.
+func manifestArguments() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"outgoing_domains": &schema.SetAttribute{
+			MarkdownDescription: "An array of strings containing the domains that functions of the app hosted by Slack are allowed to connect to. A maximum of 10 domains can be included in this array.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtMost(10),
 			},
 		},
 	}
 }
 
+func mergeAttributes(maps ...map[string]schema.Attribute) map[string]schema.Attribute {
+	merged := map[string]schema.Attribute{}
+	for _, m := range maps {
+		for key, attribute := range m {
+			merged[key] = attribute
+		}
+	}
+
+	return merged
+}
+
 func (d *SlackAppManifest) Configure(
 	_ context.Context,
 	request datasource.ConfigureRequest,
@@ -96,6 +258,51 @@ func (d *SlackAppManifest) Configure(
 	d.ctx = providerContext
 }
 
+func (d *SlackAppManifest) ValidateConfig(
+	ctx context.Context,
+	request datasource.ValidateConfigRequest,
+	response *datasource.ValidateConfigResponse,
+) {
+	// Only the attributes being checked are read, as the rest of the config may not be known yet.
+	var assistantView types.Object
+
+	response.Diagnostics.Append(
+		request.Config.GetAttribute(ctx, path.Root("features").AtName("assistant_view"), &assistantView)...,
+	)
+
+	if response.Diagnostics.HasError() || assistantView.IsNull() || assistantView.IsUnknown() {
+		return
+	}
+
+	// The scopes below an unknown block are read as null, so the blocks have to be checked as well.
+	var oauthConfig, scopes types.Object
+	var botScopes types.Set
+
+	scopesPath := path.Root("oauth_config").AtName("scopes")
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("oauth_config"), &oauthConfig)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, scopesPath, &scopes)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, scopesPath.AtName("bot"), &botScopes)...)
+
+	if response.Diagnostics.HasError() || oauthConfig.IsUnknown() || scopes.IsUnknown() || botScopes.IsUnknown() {
+		return
+	}
+
+	if slices.Contains(typeconv.MustStringSetAsArray(&botScopes), slackappmanifest.AssistantScope) {
+		return
+	}
+
+	response.Diagnostics.AddAttributeWarning(
+		path.Root("features").AtName("assistant_view"),
+		"Missing scope for the assistant view",
+		fmt.Sprintf(
+			"Apps with an assistant view need the %q bot scope, which is not in oauth_config.scopes.bot. "+
+				"Add it to let the app act as an assistant, and consider subscribing to the "+
+				"assistant_thread_started and assistant_thread_context_changed bot events.",
+			slackappmanifest.AssistantScope,
+		),
+	)
+}
+
 func (d *SlackAppManifest) Read(
 	ctx context.Context,
 	request datasource.ReadRequest,
@@ -105,6 +312,8 @@ func (d *SlackAppManifest) Read(
 
 	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
 
+	response.Diagnostics.Append(slackappmanifest.ValidateWorkflowInputs(data.Workflows)...)
+
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -114,9 +323,19 @@ func (d *SlackAppManifest) Read(
 	json, err := appManifest.ToJsonString()
 	if err != nil {
 		response.Diagnostics.AddError("Failed to marshal the manifest into JSON.", err.Error())
+
+		return
+	}
+
+	yaml, err := manifest.JSONToYAML(json)
+	if err != nil {
+		response.Diagnostics.AddError("Failed to marshal the manifest into YAML.", err.Error())
+
+		return
 	}
 
 	data.Json = types.StringValue(json)
+	data.Yaml = types.StringValue(yaml)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
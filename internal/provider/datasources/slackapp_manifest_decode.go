@@ -0,0 +1,206 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources/slackappmanifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/typeconv"
+)
+
+// DecodedManifestModel holds the attributes of a decoded manifest, shared by the data sources reading existing
+// manifests. The framework does not support embedded structs in models, so the data sources get their own arguments
+// and set these attributes next to them.
+type DecodedManifestModel struct {
+	Metadata           *slackappmanifest.Metadata           `tfsdk:"metadata"`
+	DisplayInformation *slackappmanifest.DisplayInformation `tfsdk:"display_information"`
+	Settings           *slackappmanifest.Settings           `tfsdk:"settings"`
+	Features           *slackappmanifest.Features           `tfsdk:"features"`
+	OauthConfig        *slackappmanifest.OauthConfig        `tfsdk:"oauth_config"`
+	Functions          []slackappmanifest.Function          `tfsdk:"function"`
+	Workflows          []slackappmanifest.Workflow          `tfsdk:"workflow"`
+	Datastores         []slackappmanifest.Datastore         `tfsdk:"datastore"`
+	Types              []slackappmanifest.CustomType        `tfsdk:"type"`
+	OutgoingDomains    types.Set                            `tfsdk:"outgoing_domains"`
+	Json               types.String                         `tfsdk:"json"`
+	Yaml               types.String                         `tfsdk:"yaml"`
+}
+
+// decodeManifest decodes the manifest given as JSON, along with its YAML representation.
+func decodeManifest(app manifest.App, json string, yaml string) DecodedManifestModel {
+	displayInformation := slackappmanifest.NewDisplayInformation(app.DisplayInformation)
+
+	return DecodedManifestModel{
+		Metadata:           typeconv.MapOption(app.Metadata, slackappmanifest.NewMetadata),
+		DisplayInformation: &displayInformation,
+		Settings:           typeconv.MapOption(app.Settings, slackappmanifest.NewSettings),
+		Features:           typeconv.MapOption(app.Features, slackappmanifest.NewFeatures),
+		OauthConfig:        typeconv.MapOption(app.OauthConfig, slackappmanifest.NewOauthConfig),
+		Functions:          slackappmanifest.NewFunctions(app.Functions),
+		Workflows:          slackappmanifest.NewWorkflows(app.Workflows),
+		Datastores:         slackappmanifest.NewDatastores(app.Datastores),
+		Types:              slackappmanifest.NewCustomTypes(app.Types),
+		OutgoingDomains:    typeconv.StringArrayAsSet(app.OutgoingDomains),
+		Json:               types.StringValue(json),
+		Yaml:               types.StringValue(yaml),
+	}
+}
+
+// setState sets the attributes into the state of the data source.
+func (m *DecodedManifestModel) setState(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	attributes := map[string]any{
+		"metadata":            m.Metadata,
+		"display_information": m.DisplayInformation,
+		"settings":            m.Settings,
+		"features":            m.Features,
+		"oauth_config":        m.OauthConfig,
+		"function":            m.Functions,
+		"workflow":            m.Workflows,
+		"datastore":           m.Datastores,
+		"type":                m.Types,
+		"outgoing_domains":    m.OutgoingDomains,
+		"json":                m.Json,
+		"yaml":                m.Yaml,
+	}
+	for name, value := range attributes {
+		diagnostics.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	return diagnostics
+}
+
+// decodedManifestAttributes returns the schema of the attributes of DecodedManifestModel, along with the arguments.
+func decodedManifestAttributes(arguments map[string]schema.Attribute) (map[string]schema.Attribute, error) {
+	computed, err := slackappmanifest.ComputedAttributes(manifestBlocks(), manifestArguments())
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeAttributes(
+		computed,
+		map[string]schema.Attribute{
+			"json": &schema.StringAttribute{
+				MarkdownDescription: "JSON representation of the manifest.",
+				Computed:            true,
+			},
+			"yaml": &schema.StringAttribute{
+				MarkdownDescription: "YAML representation of the manifest, as used by the app configuration pages and the Slack CLI.",
+				Computed:            true,
+			},
+		},
+		arguments,
+	), nil
+}
+
+type SlackAppManifestDecode struct {
+	ctx *common.ProviderContext
+}
+
+func NewSlackAppManifestDecode() datasource.DataSource {
+	return &SlackAppManifestDecode{}
+}
+
+func (d *SlackAppManifestDecode) Metadata(
+	_ context.Context,
+	_ datasource.MetadataRequest,
+	response *datasource.MetadataResponse,
+) {
+	response.TypeName = "slackapp_manifest_decode"
+}
+
+func (d *SlackAppManifestDecode) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	attributes, err := decodedManifestAttributes(
+		map[string]schema.Attribute{
+			"source": &schema.StringAttribute{
+				MarkdownDescription: "A JSON or YAML app manifest encoded as a string, e.g. read with the `file` function.",
+				Required:            true,
+			},
+		},
+	)
+	if err != nil {
+		response.Diagnostics.AddError("Failed to build the schema of the decoded manifest.", err.Error())
+
+		return
+	}
+
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Decodes an existing JSON or YAML manifest of the Slack App into attributes structured like the blocks of `slackapp_manifest`.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *SlackAppManifestDecode) Configure(
+	_ context.Context,
+	request datasource.ConfigureRequest,
+	response *datasource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerContext, ok := request.ProviderData.(*common.ProviderContext)
+	if !ok {
+		response.Diagnostics.AddError(
+			"The ctx did not configured properly.",
+			"request.ProviderData.(type) != *ctx.ConfiguredProvider",
+		)
+
+		return
+	}
+
+	d.ctx = providerContext
+}
+
+func (d *SlackAppManifestDecode) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var source types.String
+
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("source"), &source)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	json, err := manifest.ToJSON(source.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source"), "Source must be a valid JSON or YAML.", err.Error())
+
+		return
+	}
+
+	appManifest, err := manifest.Parse(json)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source"), "Source must be a valid manifest.", err.Error())
+
+		return
+	}
+
+	yaml, err := manifest.JSONToYAML(json)
+	if err != nil {
+		response.Diagnostics.AddError("Failed to marshal the manifest into YAML.", err.Error())
+
+		return
+	}
+
+	data := decodeManifest(*appManifest, json, yaml)
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("source"), source)...)
+	response.Diagnostics.Append(data.setState(ctx, &response.State)...)
+}
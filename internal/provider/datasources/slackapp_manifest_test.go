@@ -0,0 +1,285 @@
+package datasources_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources/slackappmanifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+func TestGenerateManifestHCLFunctionWithoutParameters(t *testing.T) {
+	tests := []struct {
+		name         string
+		manifestJSON string
+	}{
+		{
+			name:         "empty parameter sets",
+			manifestJSON: `{"display_information":{"name":"app"},"functions":{"noop":{"title":"Noop","input_parameters":{"properties":{}},"output_parameters":{"properties":{}}}}}`,
+		},
+		{
+			name:         "missing parameter sets",
+			manifestJSON: `{"display_information":{"name":"app"},"functions":{"noop":{"title":"Noop"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				app, err := manifest.Parse(tt.manifestJSON)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				hcl, diags := datasources.GenerateManifestHCL(context.Background(), "test", *app)
+				if diags.HasError() || diags.WarningsCount() > 0 {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+
+				if !strings.Contains(hcl, `callback_id = "noop"`) {
+					t.Errorf("the function is missing from the generated configuration:\n%s", hcl)
+				}
+			},
+		)
+	}
+}
+
+func TestGenerateManifestHCLDroppedFields(t *testing.T) {
+	app, err := manifest.Parse(`{"display_information":{"name":"app","future_field":true}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hcl, diags := datasources.GenerateManifestHCL(context.Background(), "test", *app)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if diags.WarningsCount() != 1 || !strings.Contains(hcl, "#   /display_information/future_field\n") {
+		t.Errorf("the unsupported field was not reported, got %v:\n%s", diags, hcl)
+	}
+}
+
+// manifestConfig returns the configuration of slackapp_manifest that generates the manifest.
+func manifestConfig(t *testing.T, manifestJSON string) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+
+	app, err := manifest.Parse(manifestJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schemaResponse datasource.SchemaResponse
+
+	datasources.NewSlackAppManifest().Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+	state := tfsdk.State{Schema: schemaResponse.Schema}
+	model := datasources.NewSlackAppManifestModel(*app)
+
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestSlackAppManifestValidateAssistantView(t *testing.T) {
+	tests := []struct {
+		name         string
+		manifestJSON string
+		wantWarning  bool
+	}{
+		{
+			name:         "without the scope",
+			manifestJSON: `{"display_information":{"name":"app"},"features":{"assistant_view":{"assistant_description":"Helps."}},"oauth_config":{"scopes":{"bot":["chat:write"]}}}`,
+			wantWarning:  true,
+		},
+		{
+			name:         "without scopes",
+			manifestJSON: `{"display_information":{"name":"app"},"features":{"assistant_view":{"assistant_description":"Helps."}}}`,
+			wantWarning:  true,
+		},
+		{
+			name:         "with the scope",
+			manifestJSON: `{"display_information":{"name":"app"},"features":{"assistant_view":{"assistant_description":"Helps."}},"oauth_config":{"scopes":{"bot":["assistant:write","chat:write"]}}}`,
+			wantWarning:  false,
+		},
+		{
+			name:         "without assistant view",
+			manifestJSON: `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write"]}}}`,
+			wantWarning:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				dataSource, ok := datasources.NewSlackAppManifest().(datasource.DataSourceWithValidateConfig)
+				if !ok {
+					t.Fatal("slackapp_manifest does not validate its configuration")
+				}
+
+				var response datasource.ValidateConfigResponse
+
+				dataSource.ValidateConfig(
+					context.Background(),
+					datasource.ValidateConfigRequest{Config: manifestConfig(t, tt.manifestJSON)},
+					&response,
+				)
+
+				if response.Diagnostics.HasError() {
+					t.Fatal(response.Diagnostics)
+				}
+
+				if got := response.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+					t.Errorf("warned = %t, want %t: %v", got, tt.wantWarning, response.Diagnostics)
+				}
+			},
+		)
+	}
+}
+
+// assertComputedMirror checks that every block and argument of slackapp_manifest has a computed counterpart.
+func assertComputedMirror(
+	t *testing.T,
+	prefix string,
+	blocks map[string]schema.Block,
+	attributes map[string]schema.Attribute,
+	computed map[string]schema.Attribute,
+) {
+	t.Helper()
+
+	for name := range attributes {
+		if attribute, ok := computed[name]; !ok || !attribute.IsComputed() || attribute.IsOptional() || attribute.IsRequired() {
+			t.Errorf("%s%s is not a computed attribute", prefix, name)
+		}
+	}
+
+	for name, block := range blocks {
+		switch b := block.(type) {
+		case *schema.SingleNestedBlock:
+			attribute, ok := computed[name].(*schema.SingleNestedAttribute)
+			if !ok || !attribute.Computed {
+				t.Errorf("%s%s is not a computed single nested attribute", prefix, name)
+
+				continue
+			}
+
+			assertComputedMirror(t, prefix+name+".", b.Blocks, b.Attributes, attribute.Attributes)
+		case *schema.ListNestedBlock:
+			attribute, ok := computed[name].(*schema.ListNestedAttribute)
+			if !ok || !attribute.Computed {
+				t.Errorf("%s%s is not a computed list nested attribute", prefix, name)
+
+				continue
+			}
+
+			assertComputedMirror(
+				t,
+				prefix+name+".",
+				b.NestedObject.Blocks,
+				b.NestedObject.Attributes,
+				attribute.NestedObject.Attributes,
+			)
+		default:
+			t.Errorf("%s%s is an unexpected block %T", prefix, name, block)
+		}
+	}
+}
+
+func TestDecodedManifestSchemaMirrorsManifest(t *testing.T) {
+	ctx := context.Background()
+
+	var manifestSchema datasource.SchemaResponse
+
+	datasources.NewSlackAppManifest().Schema(ctx, datasource.SchemaRequest{}, &manifestSchema)
+
+	arguments := make(map[string]schema.Attribute)
+
+	for name, attribute := range manifestSchema.Schema.Attributes {
+		if !attribute.IsComputed() {
+			arguments[name] = attribute
+		}
+	}
+
+	for _, dataSource := range []datasource.DataSource{datasources.NewSlackAppManifestDecode(), datasources.NewSlackApp()} {
+		var response datasource.SchemaResponse
+
+		dataSource.Schema(ctx, datasource.SchemaRequest{}, &response)
+
+		if response.Diagnostics.HasError() {
+			t.Fatal(response.Diagnostics)
+		}
+
+		if diags := response.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatal(diags)
+		}
+
+		assertComputedMirror(t, "", manifestSchema.Schema.Blocks, arguments, response.Schema.Attributes)
+	}
+}
+
+func TestSlackAppManifestReadInvalidWorkflowInputs(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResponse datasource.SchemaResponse
+
+	dataSource := datasources.NewSlackAppManifest()
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+	model := datasources.NewSlackAppManifestModel(manifest.App{DisplayInformation: manifest.DisplayInformation{Name: "app"}})
+	model.Workflows = []slackappmanifest.Workflow{
+		{
+			CallbackID:  types.StringValue("broken"),
+			Title:       types.StringValue("Broken"),
+			Description: types.StringNull(),
+			Steps: []slackappmanifest.Step{
+				{
+					ID:         types.StringValue("0"),
+					FunctionID: types.StringValue("#/functions/noop"),
+					// Validators skip values unknown at validation, so Read can see inputs that were never validated.
+					Inputs: types.StringValue("not JSON"),
+				},
+			},
+		},
+	}
+
+	state := tfsdk.State{Schema: schemaResponse.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	response := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+
+	dataSource.Read(
+		ctx,
+		datasource.ReadRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}},
+		&response,
+	)
+
+	want := path.Root("workflow").AtListIndex(0).AtName("step").AtListIndex(0).AtName("inputs")
+
+	errors := response.Diagnostics.Errors()
+	if len(errors) != 1 {
+		t.Fatalf("unexpected diagnostics: %v", response.Diagnostics)
+	}
+
+	if withPath, ok := errors[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(want) {
+		t.Errorf("the error %v is not at %s", errors[0], want)
+	}
+
+	if !response.State.Raw.IsNull() {
+		t.Error("the manifest was generated from the invalid inputs")
+	}
+}
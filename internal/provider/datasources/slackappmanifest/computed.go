@@ -0,0 +1,159 @@
+package slackappmanifest
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// ComputedAttributes converts the blocks and arguments describing a manifest into computed nested attributes with the
+// same structure, so that decoded manifests can be read in the same shape as they are written.
+func ComputedAttributes(
+	blocks map[string]schema.Block,
+	attributes map[string]schema.Attribute,
+) (map[string]schema.Attribute, error) {
+	computed := make(map[string]schema.Attribute, len(blocks)+len(attributes))
+
+	for name, attribute := range attributes {
+		c, err := computedAttribute(attribute)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		computed[name] = c
+	}
+
+	for name, block := range blocks {
+		c, err := computedBlock(block)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		computed[name] = c
+	}
+
+	return computed, nil
+}
+
+func computedAttribute(attribute schema.Attribute) (schema.Attribute, error) {
+	switch a := attribute.(type) {
+	case *schema.StringAttribute:
+		return &schema.StringAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Computed:            true,
+		}, nil
+	case *schema.BoolAttribute:
+		return &schema.BoolAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Computed:            true,
+		}, nil
+	case *schema.Int64Attribute:
+		return &schema.Int64Attribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Computed:            true,
+		}, nil
+	case *schema.Float64Attribute:
+		return &schema.Float64Attribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Computed:            true,
+		}, nil
+	case *schema.NumberAttribute:
+		return &schema.NumberAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Computed:            true,
+		}, nil
+	case *schema.SetAttribute:
+		return &schema.SetAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			ElementType:         a.ElementType,
+			Computed:            true,
+		}, nil
+	case *schema.ListAttribute:
+		return &schema.ListAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			ElementType:         a.ElementType,
+			Computed:            true,
+		}, nil
+	case *schema.MapAttribute:
+		return &schema.MapAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			ElementType:         a.ElementType,
+			Computed:            true,
+		}, nil
+	case *schema.SingleNestedAttribute:
+		attributes, err := ComputedAttributes(nil, a.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schema.SingleNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Attributes:          attributes,
+			Computed:            true,
+		}, nil
+	case *schema.ListNestedAttribute:
+		attributes, err := ComputedAttributes(nil, a.NestedObject.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schema.ListNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			NestedObject:        schema.NestedAttributeObject{Attributes: attributes},
+			Computed:            true,
+		}, nil
+	case *schema.SetNestedAttribute:
+		attributes, err := ComputedAttributes(nil, a.NestedObject.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schema.SetNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			NestedObject:        schema.NestedAttributeObject{Attributes: attributes},
+			Computed:            true,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported attribute type %T", attribute)
+	}
+}
+
+func computedBlock(block schema.Block) (schema.Attribute, error) {
+	switch b := block.(type) {
+	case *schema.SingleNestedBlock:
+		attributes, err := ComputedAttributes(b.Blocks, b.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schema.SingleNestedAttribute{
+			MarkdownDescription: b.MarkdownDescription,
+			Attributes:          attributes,
+			Computed:            true,
+		}, nil
+	case *schema.ListNestedBlock:
+		attributes, err := ComputedAttributes(b.NestedObject.Blocks, b.NestedObject.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schema.ListNestedAttribute{
+			MarkdownDescription: b.MarkdownDescription,
+			NestedObject:        schema.NestedAttributeObject{Attributes: attributes},
+			Computed:            true,
+		}, nil
+	case *schema.SetNestedBlock:
+		attributes, err := ComputedAttributes(b.NestedObject.Blocks, b.NestedObject.Attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schema.SetNestedAttribute{
+			MarkdownDescription: b.MarkdownDescription,
+			NestedObject:        schema.NestedAttributeObject{Attributes: attributes},
+			Computed:            true,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported block type %T", block)
+	}
+}
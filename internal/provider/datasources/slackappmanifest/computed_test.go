@@ -0,0 +1,95 @@
+package slackappmanifest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources/slackappmanifest"
+)
+
+func TestComputedAttributes(t *testing.T) {
+	computed, err := slackappmanifest.ComputedAttributes(
+		map[string]schema.Block{
+			"single": &schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"name": &schema.StringAttribute{Required: true},
+				},
+				Blocks: map[string]schema.Block{
+					"list": &schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"count": &schema.Int64Attribute{Optional: true},
+							},
+						},
+					},
+				},
+			},
+			"set": &schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"labels": &schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					},
+				},
+			},
+		},
+		map[string]schema.Attribute{
+			"enabled": &schema.BoolAttribute{Optional: true},
+			"domains": &schema.SetAttribute{ElementType: types.StringType, Optional: true},
+			"nested": &schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"ratio": &schema.Float64Attribute{Optional: true},
+				},
+				Optional: true,
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	single, ok := computed["single"].(*schema.SingleNestedAttribute)
+	if !ok || !single.Computed {
+		t.Fatalf("the single nested block became %#v", computed["single"])
+	}
+
+	list, ok := single.Attributes["list"].(*schema.ListNestedAttribute)
+	if !ok || !list.Computed {
+		t.Fatalf("the nested list block became %#v", single.Attributes["list"])
+	}
+
+	if count := list.NestedObject.Attributes["count"]; count == nil || !count.IsComputed() || count.IsOptional() {
+		t.Errorf("the attribute in the nested list became %#v", count)
+	}
+
+	if set, ok := computed["set"].(*schema.SetNestedAttribute); !ok || !set.Computed {
+		t.Errorf("the set nested block became %#v", computed["set"])
+	}
+
+	for _, name := range []string{"enabled", "domains", "nested"} {
+		if attribute := computed[name]; attribute == nil || !attribute.IsComputed() || attribute.IsOptional() {
+			t.Errorf("the attribute %s became %#v", name, attribute)
+		}
+	}
+}
+
+func TestComputedAttributesUnsupported(t *testing.T) {
+	_, err := slackappmanifest.ComputedAttributes(
+		map[string]schema.Block{
+			"single": &schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"object": &schema.ObjectAttribute{Optional: true},
+				},
+			},
+		},
+		nil,
+	)
+	if err == nil {
+		t.Fatal("an unsupported attribute was converted without an error")
+	}
+
+	if want := "single: object: unsupported attribute type *schema.ObjectAttribute"; err.Error() != want {
+		t.Errorf("the error is %q, want %q", err, want)
+	}
+}
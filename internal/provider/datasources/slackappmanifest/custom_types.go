@@ -0,0 +1,101 @@
+package slackappmanifest
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/myvalidator"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/typeconv"
+)
+
+type CustomType struct {
+	// Blocks
+	Properties []Parameter `tfsdk:"property"`
+
+	// Arguments
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (*CustomType) Schema() *schema.ListNestedBlock {
+	return &schema.ListNestedBlock{
+		MarkdownDescription: "An array of settings groups that describe the [custom types](https://api.slack.com/automation/types/custom-types) of the app, which can be referred to as `#/types/<name>`.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"property": (*Parameter)(nil).schema(
+					"An array of settings groups that describe the properties of the type, when it is an `object`.",
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"name": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the name of the type.",
+					Required:            true,
+					Validators:          callbackIDValidators(),
+				},
+				"type": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the underlying [type](https://api.slack.com/automation/types), such as `object`.",
+					Required:            true,
+					Validators:          parameterTypeValidators(),
+				},
+				"title": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the title of the type.",
+					Optional:            true,
+				},
+				"description": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the description of the type.",
+					Optional:            true,
+				},
+			},
+		},
+		Validators: []validator.List{
+			myvalidator.UniqueAttribute("name"),
+		},
+	}
+}
+
+func (t CustomType) Read() manifest.CustomType {
+	customType := manifest.CustomType{
+		Type:        t.Type.ValueString(),
+		Title:       t.Title.ValueStringPointer(),
+		Description: t.Description.ValueStringPointer(),
+	}
+
+	if len(t.Properties) > 0 {
+		properties := readParameterSet(t.Properties)
+		customType.Properties = properties.Properties
+		customType.Required = properties.Required
+	}
+
+	return customType
+}
+
+func ReadCustomTypes(customTypes []CustomType) map[string]manifest.CustomType {
+	return typeconv.MapKeyedListModel[manifest.CustomType](
+		customTypes, func(t CustomType) string {
+			return t.Name.ValueString()
+		},
+	)
+}
+
+func NewCustomTypes(customTypes map[string]manifest.CustomType) []CustomType {
+	return typeconv.MapKeyedList(
+		customTypes, func(name string, t manifest.CustomType) CustomType {
+			var properties []Parameter
+			if len(t.Properties) > 0 {
+				properties = newParameters(&manifest.ParameterSet{Properties: t.Properties, Required: t.Required})
+			}
+
+			return CustomType{
+				Properties:  properties,
+				Name:        types.StringValue(name),
+				Type:        types.StringValue(t.Type),
+				Title:       types.StringPointerValue(t.Title),
+				Description: types.StringPointerValue(t.Description),
+			}
+		},
+	)
+}
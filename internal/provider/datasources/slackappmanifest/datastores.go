@@ -0,0 +1,119 @@
+package slackappmanifest
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/myvalidator"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/typeconv"
+)
+
+type DatastoreAttribute struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (*DatastoreAttribute) schema() *schema.ListNestedBlock {
+	return &schema.ListNestedBlock{
+		MarkdownDescription: "An array of settings groups that describe the attributes of the items in the datastore.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the name of the attribute.",
+					Required:            true,
+					Validators:          callbackIDValidators(),
+				},
+				"type": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the [type](https://api.slack.com/automation/types) of the attribute.",
+					Required:            true,
+					Validators:          parameterTypeValidators(),
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			myvalidator.UniqueAttribute("name"),
+		},
+	}
+}
+
+func (a DatastoreAttribute) Read() manifest.DatastoreAttribute {
+	return manifest.DatastoreAttribute{
+		Type: a.Type.ValueString(),
+	}
+}
+
+type Datastore struct {
+	// Blocks
+	Attributes []DatastoreAttribute `tfsdk:"attribute"`
+
+	// Arguments
+	Name       types.String `tfsdk:"name"`
+	PrimaryKey types.String `tfsdk:"primary_key"`
+}
+
+func (*Datastore) Schema() *schema.ListNestedBlock {
+	return &schema.ListNestedBlock{
+		MarkdownDescription: "An array of settings groups that describe the [datastores](https://api.slack.com/automation/datastores) of the app.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"attribute": (*DatastoreAttribute)(nil).schema(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"name": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the name of the datastore.",
+					Required:            true,
+					Validators:          callbackIDValidators(),
+				},
+				"primary_key": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the name of the attribute that uniquely identifies an item in the datastore.",
+					Required:            true,
+				},
+			},
+		},
+		Validators: []validator.List{
+			myvalidator.UniqueAttribute("name"),
+		},
+	}
+}
+
+func (d Datastore) Read() manifest.Datastore {
+	return manifest.Datastore{
+		PrimaryKey: d.PrimaryKey.ValueString(),
+		Attributes: typeconv.MapKeyedListModel[manifest.DatastoreAttribute](
+			d.Attributes, func(a DatastoreAttribute) string {
+				return a.Name.ValueString()
+			},
+		),
+	}
+}
+
+func ReadDatastores(datastores []Datastore) map[string]manifest.Datastore {
+	return typeconv.MapKeyedListModel[manifest.Datastore](
+		datastores, func(d Datastore) string {
+			return d.Name.ValueString()
+		},
+	)
+}
+
+func NewDatastores(datastores map[string]manifest.Datastore) []Datastore {
+	return typeconv.MapKeyedList(
+		datastores, func(name string, d manifest.Datastore) Datastore {
+			return Datastore{
+				Attributes: typeconv.MapKeyedList(
+					d.Attributes, func(name string, a manifest.DatastoreAttribute) DatastoreAttribute {
+						return DatastoreAttribute{
+							Name: types.StringValue(name),
+							Type: types.StringValue(a.Type),
+						}
+					},
+				),
+				Name:       types.StringValue(name),
+				PrimaryKey: types.StringValue(d.PrimaryKey),
+			}
+		},
+	)
+}
@@ -69,3 +69,12 @@ func (i *DisplayInformation) Read() manifest.DisplayInformation {
 		BackgroundColor: i.BackgroundColor.ValueStringPointer(),
 	}
 }
+
+func NewDisplayInformation(i manifest.DisplayInformation) DisplayInformation {
+	return DisplayInformation{
+		Name:            types.StringValue(i.Name),
+		Description:     types.StringPointerValue(i.Description),
+		LongDescription: types.StringPointerValue(i.LongDescription),
+		BackgroundColor: types.StringPointerValue(i.BackgroundColor),
+	}
+}
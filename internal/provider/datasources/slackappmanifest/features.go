@@ -50,6 +50,88 @@ func (h AppHome) Read() manifest.AppHome {
 	}
 }
 
+// AssistantScope is the bot scope that apps need to act as an [AI assistant](https://api.slack.com/docs/apps/ai).
+const AssistantScope = "assistant:write"
+
+const maxSuggestedPromptCount = 4
+
+type SuggestedPrompt struct {
+	Title   types.String `tfsdk:"title"`
+	Message types.String `tfsdk:"message"`
+}
+
+func (*SuggestedPrompt) schema() *schema.ListNestedBlock {
+	return &schema.ListNestedBlock{
+		MarkdownDescription: "An array of settings groups that describe the prompts suggested to users when they open the assistant. A maximum of 4 suggested prompts can be included in this array.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"title": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the title of the prompt shown to users.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"message": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the message sent to the assistant when users choose the prompt.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(maxSuggestedPromptCount),
+		},
+	}
+}
+
+func (p SuggestedPrompt) Read() manifest.SuggestedPrompt {
+	return manifest.SuggestedPrompt{
+		Title:   p.Title.ValueString(),
+		Message: p.Message.ValueString(),
+	}
+}
+
+type AssistantView struct {
+	// Blocks
+	SuggestedPrompts []SuggestedPrompt `tfsdk:"suggested_prompt"`
+
+	// Arguments
+	AssistantDescription types.String `tfsdk:"assistant_description"`
+}
+
+func (*AssistantView) schema() *schema.SingleNestedBlock {
+	return &schema.SingleNestedBlock{
+		MarkdownDescription: "A subgroup of settings that describe the [AI assistant](https://api.slack.com/docs/apps/ai) view of the app. The app also needs the `assistant:write` bot scope, and usually subscribes to the `assistant_thread_started` and `assistant_thread_context_changed` bot events.",
+		Blocks: map[string]schema.Block{
+			"suggested_prompt": (*SuggestedPrompt)(nil).schema(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"assistant_description": &schema.StringAttribute{
+				MarkdownDescription: "A string containing the description of the assistant shown to users.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.AlsoRequires(
+				path.MatchRelative().AtName("assistant_description"),
+			),
+		},
+	}
+}
+
+func (v AssistantView) Read() manifest.AssistantView {
+	return manifest.AssistantView{
+		AssistantDescription: v.AssistantDescription.ValueString(),
+		SuggestedPrompts:     typeconv.MapListModel[manifest.SuggestedPrompt](v.SuggestedPrompts),
+	}
+}
+
 type BotUser struct {
 	DisplayName  types.String `tfsdk:"display_name"`
 	AlwaysOnline types.Bool   `tfsdk:"always_online"`
@@ -247,6 +329,7 @@ func (s WorkflowStep) Read() manifest.WorkflowStep {
 type Features struct {
 	// Blocks
 	AppHome       *AppHome       `tfsdk:"app_home"`
+	AssistantView *AssistantView `tfsdk:"assistant_view"`
 	BotUser       *BotUser       `tfsdk:"bot_user"`
 	Shortcuts     []Shortcut     `tfsdk:"shortcut"`
 	SlashCommands []SlashCommand `tfsdk:"slash_command"`
@@ -260,11 +343,12 @@ func (*Features) Schema() *schema.SingleNestedBlock {
 	return &schema.SingleNestedBlock{
 		MarkdownDescription: "A group of settings corresponding to the **Features** section of the app config pages.",
 		Blocks: map[string]schema.Block{
-			"app_home":      (*AppHome)(nil).schema(),
-			"bot_user":      (*BotUser)(nil).schema(),
-			"shortcut":      (*Shortcut)(nil).schema(),
-			"slash_command": (*SlashCommand)(nil).schema(),
-			"workflow_step": (*WorkflowStep)(nil).schema(),
+			"app_home":       (*AppHome)(nil).schema(),
+			"assistant_view": (*AssistantView)(nil).schema(),
+			"bot_user":       (*BotUser)(nil).schema(),
+			"shortcut":       (*Shortcut)(nil).schema(),
+			"slash_command":  (*SlashCommand)(nil).schema(),
+			"workflow_step":  (*WorkflowStep)(nil).schema(),
 		},
 		Attributes: map[string]schema.Attribute{
 			"unfurl_domains": &schema.SetAttribute{
@@ -279,6 +363,7 @@ func (*Features) Schema() *schema.SingleNestedBlock {
 func (f Features) Read() manifest.Features {
 	return manifest.Features{
 		AppHome:       typeconv.MapOptionModel[manifest.AppHome](f.AppHome),
+		AssistantView: typeconv.MapOptionModel[manifest.AssistantView](f.AssistantView),
 		BotUser:       typeconv.MapOptionModel[manifest.BotUser](f.BotUser),
 		Shortcuts:     typeconv.MapListModel[manifest.Shortcut](f.Shortcuts),
 		SlashCommands: typeconv.MapListModel[manifest.SlashCommand](f.SlashCommands),
@@ -286,3 +371,70 @@ func (f Features) Read() manifest.Features {
 		WorkflowSteps: typeconv.MapListModel[manifest.WorkflowStep](f.WorkflowSteps),
 	}
 }
+
+func NewAppHome(h manifest.AppHome) AppHome {
+	return AppHome{
+		HomeTabEnabled:             types.BoolPointerValue(h.HomeTabEnabled),
+		MessagesTabEnabled:         types.BoolPointerValue(h.MessagesTabEnabled),
+		MessagesTabReadOnlyEnabled: types.BoolPointerValue(h.MessagesTabReadOnlyEnabled),
+	}
+}
+
+func NewSuggestedPrompt(p manifest.SuggestedPrompt) SuggestedPrompt {
+	return SuggestedPrompt{
+		Title:   types.StringValue(p.Title),
+		Message: types.StringValue(p.Message),
+	}
+}
+
+func NewAssistantView(v manifest.AssistantView) AssistantView {
+	return AssistantView{
+		SuggestedPrompts:     typeconv.MapList(v.SuggestedPrompts, NewSuggestedPrompt),
+		AssistantDescription: types.StringValue(v.AssistantDescription),
+	}
+}
+
+func NewBotUser(u manifest.BotUser) BotUser {
+	return BotUser{
+		DisplayName:  types.StringValue(u.DisplayName),
+		AlwaysOnline: types.BoolPointerValue(u.AlwaysOnline),
+	}
+}
+
+func NewShortcut(s manifest.Shortcut) Shortcut {
+	return Shortcut{
+		Name:        types.StringValue(s.Name),
+		CallbackID:  types.StringValue(s.CallbackID),
+		Description: types.StringValue(s.Description),
+		Type:        types.StringValue(string(s.Type)),
+	}
+}
+
+func NewSlashCommand(c manifest.SlashCommand) SlashCommand {
+	return SlashCommand{
+		Command:      types.StringValue(c.Command),
+		Description:  types.StringValue(c.Description),
+		ShouldEscape: types.BoolPointerValue(c.ShouldEscape),
+		URL:          types.StringPointerValue(c.URL),
+		UsageHint:    types.StringPointerValue(c.UsageHint),
+	}
+}
+
+func NewWorkflowStep(s manifest.WorkflowStep) WorkflowStep {
+	return WorkflowStep{
+		Name:       types.StringValue(s.Name),
+		CallbackID: types.StringValue(s.CallbackID),
+	}
+}
+
+func NewFeatures(f manifest.Features) Features {
+	return Features{
+		AppHome:       typeconv.MapOption(f.AppHome, NewAppHome),
+		AssistantView: typeconv.MapOption(f.AssistantView, NewAssistantView),
+		BotUser:       typeconv.MapOption(f.BotUser, NewBotUser),
+		Shortcuts:     typeconv.MapList(f.Shortcuts, NewShortcut),
+		SlashCommands: typeconv.MapList(f.SlashCommands, NewSlashCommand),
+		WorkflowSteps: typeconv.MapList(f.WorkflowSteps, NewWorkflowStep),
+		UnfurlDomains: typeconv.StringArrayAsSet(f.UnfurlDomains),
+	}
+}
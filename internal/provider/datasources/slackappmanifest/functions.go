@@ -0,0 +1,227 @@
+package slackappmanifest
+
+import (
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/myvalidator"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/typeconv"
+)
+
+var callbackIDPattern = regexp.MustCompile("^[a-zA-Z0-9_]+$")
+
+// parameterTypes lists the built-in types that parameters of functions and workflows can have.
+var parameterTypes = []string{
+	"string",
+	"integer",
+	"number",
+	"boolean",
+	"array",
+	"object",
+	"slack#/types/blocks",
+	"slack#/types/channel_id",
+	"slack#/types/credential/oauth2",
+	"slack#/types/date",
+	"slack#/types/expanded_rich_text",
+	"slack#/types/file_id",
+	"slack#/types/interactivity",
+	"slack#/types/list_id",
+	"slack#/types/message_context",
+	"slack#/types/message_ts",
+	"slack#/types/rich_text",
+	"slack#/types/team_id",
+	"slack#/types/timestamp",
+	"slack#/types/user_context",
+	"slack#/types/user_id",
+	"slack#/types/usergroup_id",
+}
+
+func callbackIDValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, 100),
+		stringvalidator.RegexMatches(callbackIDPattern, "must be a string that contains only `a-z`, `A-Z`, `0-9` and `_`"),
+	}
+}
+
+func parameterTypeValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.Any(
+			stringvalidator.OneOf(parameterTypes...),
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^#/types/[a-zA-Z0-9_]+$"),
+				"must be a custom type such as `#/types/my_type`",
+			),
+		),
+	}
+}
+
+type Parameter struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Required    types.Bool   `tfsdk:"required"`
+}
+
+func (*Parameter) schema(markdownDescription string) *schema.ListNestedBlock {
+	return &schema.ListNestedBlock{
+		MarkdownDescription: markdownDescription,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the name of the parameter.",
+					Required:            true,
+					Validators:          callbackIDValidators(),
+				},
+				"type": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the [type](https://api.slack.com/automation/types) of the parameter, such as `string` or `slack#/types/user_id`. Custom types are referred to as `#/types/<name>`.",
+					Required:            true,
+					Validators:          parameterTypeValidators(),
+				},
+				"title": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the label of the parameter.",
+					Optional:            true,
+				},
+				"description": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the description of the parameter.",
+					Optional:            true,
+				},
+				"required": &schema.BoolAttribute{
+					MarkdownDescription: "A boolean that specifies whether or not the parameter is required.",
+					Optional:            true,
+				},
+			},
+		},
+		Validators: []validator.List{
+			myvalidator.UniqueAttribute("name"),
+		},
+	}
+}
+
+func (p Parameter) Read() manifest.Parameter {
+	return manifest.Parameter{
+		Type:        p.Type.ValueString(),
+		Title:       p.Title.ValueStringPointer(),
+		Description: p.Description.ValueStringPointer(),
+	}
+}
+
+func readParameterSet(parameters []Parameter) *manifest.ParameterSet {
+	set := manifest.ParameterSet{
+		Properties: make(map[string]manifest.Parameter, len(parameters)),
+	}
+
+	for _, p := range parameters {
+		set.Properties[p.Name.ValueString()] = p.Read()
+
+		if p.Required.ValueBool() {
+			set.Required = append(set.Required, p.Name.ValueString())
+		}
+	}
+
+	return &set
+}
+
+type Function struct {
+	// Blocks
+	InputParameters  []Parameter `tfsdk:"input_parameter"`
+	OutputParameters []Parameter `tfsdk:"output_parameter"`
+
+	// Arguments
+	CallbackID  types.String `tfsdk:"callback_id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (*Function) Schema() *schema.ListNestedBlock {
+	return &schema.ListNestedBlock{
+		MarkdownDescription: "An array of settings groups that describe [custom functions](https://api.slack.com/automation/functions/custom) of the app, which can be used as custom steps in Workflow Builder.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"input_parameter": (*Parameter)(nil).schema(
+					"An array of settings groups that describe the input parameters of the function.",
+				),
+				"output_parameter": (*Parameter)(nil).schema(
+					"An array of settings groups that describe the output parameters of the function.",
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"callback_id": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the `callback_id` of the function. Maximum length is 100 characters. Allowed characters: `a-z`, `A-Z`, `0-9` and `_`.",
+					Required:            true,
+					Validators:          callbackIDValidators(),
+				},
+				"title": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the title of the function.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"description": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the description of the function.",
+					Optional:            true,
+				},
+			},
+		},
+		Validators: []validator.List{
+			myvalidator.UniqueAttribute("callback_id"),
+		},
+	}
+}
+
+// Read always writes both parameter sets, as Slack requires them on every function even when they are empty.
+func (f Function) Read() manifest.Function {
+	return manifest.Function{
+		Title:            f.Title.ValueString(),
+		Description:      f.Description.ValueStringPointer(),
+		InputParameters:  readParameterSet(f.InputParameters),
+		OutputParameters: readParameterSet(f.OutputParameters),
+	}
+}
+
+func ReadFunctions(functions []Function) map[string]manifest.Function {
+	return typeconv.MapKeyedListModel[manifest.Function](
+		functions, func(f Function) string {
+			return f.CallbackID.ValueString()
+		},
+	)
+}
+
+func newParameters(set *manifest.ParameterSet) []Parameter {
+	if set == nil {
+		return nil
+	}
+
+	return typeconv.MapKeyedList(
+		set.Properties, func(name string, p manifest.Parameter) Parameter {
+			return Parameter{
+				Name:        types.StringValue(name),
+				Type:        types.StringValue(p.Type),
+				Title:       types.StringPointerValue(p.Title),
+				Description: types.StringPointerValue(p.Description),
+				Required:    types.BoolValue(slices.Contains(set.Required, name)),
+			}
+		},
+	)
+}
+
+func NewFunctions(functions map[string]manifest.Function) []Function {
+	return typeconv.MapKeyedList(
+		functions, func(callbackID string, f manifest.Function) Function {
+			return Function{
+				InputParameters:  newParameters(f.InputParameters),
+				OutputParameters: newParameters(f.OutputParameters),
+				CallbackID:       types.StringValue(callbackID),
+				Title:            types.StringValue(f.Title),
+				Description:      types.StringPointerValue(f.Description),
+			}
+		},
+	)
+}
@@ -35,3 +35,10 @@ func (m Metadata) Read() manifest.Metadata {
 		MinorVersion: typeconv.Int64PtrAsIntPtr(m.MinorVersion.ValueInt64Pointer()),
 	}
 }
+
+func NewMetadata(m manifest.Metadata) Metadata {
+	return Metadata{
+		MajorVersion: types.Int64PointerValue(typeconv.IntPtrAsInt64Ptr(m.MajorVersion)),
+		MinorVersion: types.Int64PointerValue(typeconv.IntPtrAsInt64Ptr(m.MinorVersion)),
+	}
+}
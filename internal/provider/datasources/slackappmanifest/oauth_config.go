@@ -79,3 +79,17 @@ func (c OauthConfig) Read() manifest.OauthConfig {
 		Scopes:       typeconv.MapOptionModel[manifest.Scopes](c.Scopes),
 	}
 }
+
+func NewScopes(s manifest.Scopes) Scopes {
+	return Scopes{
+		Bot:  typeconv.StringArrayAsSet(s.Bot),
+		User: typeconv.StringArrayAsSet(s.User),
+	}
+}
+
+func NewOauthConfig(c manifest.OauthConfig) OauthConfig {
+	return OauthConfig{
+		Scopes:       typeconv.MapOption(c.Scopes, NewScopes),
+		RedirectURLs: typeconv.StringArrayAsSet(c.RedirectURLs),
+	}
+}
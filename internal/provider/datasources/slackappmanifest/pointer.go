@@ -0,0 +1,200 @@
+package slackappmanifest
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// pointerNode describes how a key of the JSON manifest corresponds to the schema of the data source.
+type pointerNode struct {
+	name     string
+	list     bool
+	children map[string]pointerNode
+}
+
+func leaves(names ...string) map[string]pointerNode {
+	nodes := make(map[string]pointerNode, len(names))
+	for _, name := range names {
+		nodes[name] = pointerNode{name: name}
+	}
+
+	return nodes
+}
+
+func merge(maps ...map[string]pointerNode) map[string]pointerNode {
+	merged := map[string]pointerNode{}
+	for _, m := range maps {
+		for key, node := range m {
+			merged[key] = node
+		}
+	}
+
+	return merged
+}
+
+var pointerTree = map[string]pointerNode{
+	"_metadata": {
+		name:     "metadata",
+		children: leaves("major_version", "minor_version"),
+	},
+	"display_information": {
+		name:     "display_information",
+		children: leaves("name", "description", "long_description", "background_color"),
+	},
+	"settings": {
+		name: "settings",
+		children: merge(
+			leaves(
+				"allowed_ip_address_ranges", "org_deploy_enabled", "socket_mode_enabled", "token_rotation_enabled",
+				"function_runtime", "is_hosted", "hermes_app_type",
+			),
+			map[string]pointerNode{
+				"event_subscriptions": {
+					name: "event_subscriptions",
+					children: merge(
+						leaves("request_url", "bot_events", "user_events"),
+						map[string]pointerNode{
+							"metadata_subscriptions": {
+								name:     "metadata_subscription",
+								list:     true,
+								children: leaves("app_id", "event_type"),
+							},
+						},
+					),
+				},
+				"incoming_webhooks": {
+					name:     "incoming_webhooks",
+					children: leaves("incoming_webhooks_enabled"),
+				},
+				"siws_links": {
+					name:     "siws_links",
+					children: leaves("initiate_uri"),
+				},
+				"interactivity": {
+					name:     "interactivity",
+					children: leaves("is_enabled", "request_url", "message_menu_options_url"),
+				},
+			},
+		),
+	},
+	"features": {
+		name: "features",
+		children: merge(
+			leaves("unfurl_domains"),
+			map[string]pointerNode{
+				"app_home": {
+					name:     "app_home",
+					children: leaves("home_tab_enabled", "messages_tab_enabled", "messages_tab_read_only_enabled"),
+				},
+				"assistant_view": {
+					name: "assistant_view",
+					children: merge(
+						leaves("assistant_description"),
+						map[string]pointerNode{
+							"suggested_prompts": {
+								name:     "suggested_prompt",
+								list:     true,
+								children: leaves("title", "message"),
+							},
+						},
+					),
+				},
+				"bot_user": {
+					name:     "bot_user",
+					children: leaves("display_name", "always_online"),
+				},
+				"shortcuts": {
+					name:     "shortcut",
+					list:     true,
+					children: leaves("name", "callback_id", "description", "type"),
+				},
+				"slash_commands": {
+					name:     "slash_command",
+					list:     true,
+					children: leaves("command", "description", "should_escape", "url", "usage_hint"),
+				},
+				"workflow_steps": {
+					name:     "workflow_step",
+					list:     true,
+					children: leaves("name", "callback_id"),
+				},
+			},
+		),
+	},
+	// Functions, workflows, datastores and types are keyed by name in the manifest, which cannot be mapped to an
+	// index of the blocks here.
+	"functions": {
+		name: "function",
+	},
+	"workflows": {
+		name: "workflow",
+	},
+	"datastores": {
+		name: "datastore",
+	},
+	"types": {
+		name: "type",
+	},
+	"outgoing_domains": {
+		name: "outgoing_domains",
+	},
+	"oauth_config": {
+		name: "oauth_config",
+		children: merge(
+			leaves("redirect_urls"),
+			map[string]pointerNode{
+				"scopes": {
+					name:     "scopes",
+					children: leaves("bot", "user"),
+				},
+			},
+		),
+	},
+}
+
+func unescapePointerSegment(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+}
+
+// PathFromPointer converts a JSON pointer into the manifest, as reported by Slack, into the path of the
+// corresponding block or attribute of this data source. If the pointer goes deeper than the schema can address,
+// such as an element of a set, the closest enclosing path is returned. It returns false if nothing matched.
+func PathFromPointer(pointer string) (path.Path, bool) {
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+
+	var (
+		result   path.Path
+		children = pointerTree
+		matched  = false
+	)
+
+	for i := 0; i < len(segments); i++ {
+		node, ok := children[unescapePointerSegment(segments[i])]
+		if !ok {
+			break
+		}
+
+		if matched {
+			result = result.AtName(node.name)
+		} else {
+			result = path.Root(node.name)
+			matched = true
+		}
+
+		children = node.children
+
+		if node.list && i+1 < len(segments) {
+			index, err := strconv.Atoi(segments[i+1])
+			if err != nil {
+				break
+			}
+
+			result = result.AtListIndex(index)
+			i++
+		}
+	}
+
+	return result, matched
+}
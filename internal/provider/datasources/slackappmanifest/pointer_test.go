@@ -0,0 +1,126 @@
+package slackappmanifest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources/slackappmanifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+func TestPathFromPointer(t *testing.T) {
+	tests := []struct {
+		pointer string
+		want    path.Path
+		ok      bool
+	}{
+		{
+			pointer: "/display_information/name",
+			want:    path.Root("display_information").AtName("name"),
+			ok:      true,
+		},
+		{
+			pointer: "/_metadata/major_version",
+			want:    path.Root("metadata").AtName("major_version"),
+			ok:      true,
+		},
+		{
+			pointer: "/settings/event_subscriptions/request_url",
+			want:    path.Root("settings").AtName("event_subscriptions").AtName("request_url"),
+			ok:      true,
+		},
+		{
+			pointer: "/features/slash_commands/1/usage_hint",
+			want:    path.Root("features").AtName("slash_command").AtListIndex(1).AtName("usage_hint"),
+			ok:      true,
+		},
+		{
+			pointer: "/settings/event_subscriptions/metadata_subscriptions/0/event_type",
+			want: path.Root("settings").AtName("event_subscriptions").AtName("metadata_subscription").
+				AtListIndex(0).AtName("event_type"),
+			ok: true,
+		},
+		{
+			pointer: "/features/assistant_view/suggested_prompts/3",
+			want:    path.Root("features").AtName("assistant_view").AtName("suggested_prompt").AtListIndex(3),
+			ok:      true,
+		},
+		{
+			// Elements of sets cannot be addressed, so the set itself is the closest path.
+			pointer: "/oauth_config/scopes/bot/2",
+			want:    path.Root("oauth_config").AtName("scopes").AtName("bot"),
+			ok:      true,
+		},
+		{
+			pointer: "/features/shortcuts/first/name",
+			want:    path.Root("features").AtName("shortcut"),
+			ok:      true,
+		},
+		{
+			pointer: "/features/unknown_field",
+			want:    path.Root("features"),
+			ok:      true,
+		},
+		{
+			// Functions are keyed by their callback ID, which may contain escaped characters.
+			pointer: "/functions/deploy~1app~0v2/title",
+			want:    path.Root("function"),
+			ok:      true,
+		},
+		{
+			// An escaped slash is part of the key rather than a separator.
+			pointer: "/display_information~1name",
+			ok:      false,
+		},
+		{
+			pointer: "/unknown",
+			ok:      false,
+		},
+		{
+			pointer: "",
+			ok:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.pointer, func(t *testing.T) {
+				got, ok := slackappmanifest.PathFromPointer(tt.pointer)
+				if ok != tt.ok {
+					t.Fatalf("PathFromPointer(%q) matched = %t, want %t", tt.pointer, ok, tt.ok)
+				}
+
+				if ok && !got.Equal(tt.want) {
+					t.Errorf("PathFromPointer(%q) = %s, want %s", tt.pointer, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestPathFromPointerOfDifferences(t *testing.T) {
+	differences, err := manifest.Diff(
+		`{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/a","description":"a"},{"command":"/b","description":"b"}]},"functions":{"a/b":{"title":"A"}}}`,
+		`{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/a","description":"a"},{"command":"/b","description":"B"}]},"functions":{"a/b":{"title":"B"}}}`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []path.Path{
+		path.Root("features").AtName("slash_command").AtListIndex(1).AtName("description"),
+		path.Root("function"),
+	}
+
+	if len(differences) != len(want) {
+		t.Fatalf("Diff() = %v, want %d differences", differences, len(want))
+	}
+
+	for i, difference := range differences {
+		got, ok := slackappmanifest.PathFromPointer(difference.Pointer)
+		if !ok || !got.Equal(want[i]) {
+			t.Errorf("PathFromPointer(%q) = %s, want %s", difference.Pointer, got, want[i])
+		}
+	}
+}
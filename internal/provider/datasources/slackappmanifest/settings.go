@@ -15,7 +15,55 @@ import (
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/typeconv"
 )
 
+var (
+	metadataSubscriptionAppIDPattern = regexp.MustCompile(`^(?:\*|A[A-Z0-9]+)$`)
+	httpsURLPattern                  = regexp.MustCompile(`^https://`)
+)
+
+type MetadataSubscription struct {
+	AppID     types.String `tfsdk:"app_id"`
+	EventType types.String `tfsdk:"event_type"`
+}
+
+func (*MetadataSubscription) schema() *schema.ListNestedBlock {
+	return &schema.ListNestedBlock{
+		MarkdownDescription: "An array of settings groups that describe the [message metadata](https://api.slack.com/metadata) events the app subscribes to.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"app_id": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the ID of the app that posts the metadata, or `*` to subscribe to metadata from any app.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(
+							metadataSubscriptionAppIDPattern,
+							"must be an app ID such as `A0123456789`, or `*`",
+						),
+					},
+				},
+				"event_type": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the event type of the metadata to subscribe to.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (m MetadataSubscription) Read() manifest.MetadataSubscription {
+	return manifest.MetadataSubscription{
+		AppID:     m.AppID.ValueString(),
+		EventType: m.EventType.ValueString(),
+	}
+}
+
 type EventSubscriptions struct {
+	// Blocks
+	MetadataSubscriptions []MetadataSubscription `tfsdk:"metadata_subscription"`
+
+	// Arguments
 	RequestURL types.String `tfsdk:"request_url"`
 	BotEvents  types.Set    `tfsdk:"bot_events"`
 	UserEvents types.Set    `tfsdk:"user_events"`
@@ -24,6 +72,9 @@ type EventSubscriptions struct {
 func (*EventSubscriptions) schema() *schema.SingleNestedBlock {
 	return &schema.SingleNestedBlock{
 		MarkdownDescription: "A subgroup of settings that describe [Events API](https://api.slack.com/events-api) configuration for the app.",
+		Blocks: map[string]schema.Block{
+			"metadata_subscription": (*MetadataSubscription)(nil).schema(),
+		},
 		Attributes: map[string]schema.Attribute{
 			"request_url": &schema.StringAttribute{
 				MarkdownDescription: "A string containing the full `https` URL that acts as the [Events API request URL](https://api.slack.com/events-api#the-events-api__subscribing-to-event-types__events-api-request-urls). If set, you'll need to manually verify the Request URL in the App Manifest section of [App Management](https://app.slack.com/app-settings).",
@@ -54,6 +105,56 @@ func (s EventSubscriptions) Read() manifest.EventSubscriptions {
 		RequestURL: s.RequestURL.ValueStringPointer(),
 		BotEvents:  typeconv.MustStringSetAsArray(&s.BotEvents),
 		UserEvents: typeconv.MustStringSetAsArray(&s.UserEvents),
+		MetadataSubscriptions: typeconv.MapListModel[manifest.MetadataSubscription](
+			s.MetadataSubscriptions,
+		),
+	}
+}
+
+type IncomingWebhooks struct {
+	IncomingWebhooksEnabled types.Bool `tfsdk:"incoming_webhooks_enabled"`
+}
+
+func (*IncomingWebhooks) schema() *schema.SingleNestedBlock {
+	return &schema.SingleNestedBlock{
+		MarkdownDescription: "A subgroup of settings that describe [incoming webhooks](https://api.slack.com/messaging/webhooks) configuration for the app.",
+		Attributes: map[string]schema.Attribute{
+			"incoming_webhooks_enabled": &schema.BoolAttribute{
+				MarkdownDescription: "A boolean that specifies whether or not incoming webhooks are enabled.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (i IncomingWebhooks) Read() manifest.IncomingWebhooks {
+	return manifest.IncomingWebhooks{
+		IncomingWebhooksEnabled: i.IncomingWebhooksEnabled.ValueBoolPointer(),
+	}
+}
+
+type SiwsLinks struct {
+	InitiateURI types.String `tfsdk:"initiate_uri"`
+}
+
+func (*SiwsLinks) schema() *schema.SingleNestedBlock {
+	return &schema.SingleNestedBlock{
+		MarkdownDescription: "A subgroup of settings that describe the [Sign in with Slack](https://api.slack.com/authentication/sign-in-with-slack) links of the app.",
+		Attributes: map[string]schema.Attribute{
+			"initiate_uri": &schema.StringAttribute{
+				MarkdownDescription: "A string containing the full `https` URL that starts the Sign in with Slack flow.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(httpsURLPattern, "must be an `https` URL"),
+				},
+			},
+		},
+	}
+}
+
+func (l SiwsLinks) Read() manifest.SiwsLinks {
+	return manifest.SiwsLinks{
+		InitiateURI: l.InitiateURI.ValueStringPointer(),
 	}
 }
 
@@ -99,13 +200,18 @@ func (i Interactivity) Read() manifest.Interactivity {
 type Settings struct {
 	// Blocks
 	EventSubscriptions *EventSubscriptions `tfsdk:"event_subscriptions"`
+	IncomingWebhooks   *IncomingWebhooks   `tfsdk:"incoming_webhooks"`
 	Interactivity      *Interactivity      `tfsdk:"interactivity"`
+	SiwsLinks          *SiwsLinks          `tfsdk:"siws_links"`
 
 	// Arguments
-	AllowedIPAddressRanges types.Set  `tfsdk:"allowed_ip_address_ranges"`
-	OrgDeployEnabled       types.Bool `tfsdk:"org_deploy_enabled"`
-	SocketModeEnabled      types.Bool `tfsdk:"socket_mode_enabled"`
-	TokenRotationEnabled   types.Bool `tfsdk:"token_rotation_enabled"`
+	AllowedIPAddressRanges types.Set    `tfsdk:"allowed_ip_address_ranges"`
+	OrgDeployEnabled       types.Bool   `tfsdk:"org_deploy_enabled"`
+	SocketModeEnabled      types.Bool   `tfsdk:"socket_mode_enabled"`
+	TokenRotationEnabled   types.Bool   `tfsdk:"token_rotation_enabled"`
+	FunctionRuntime        types.String `tfsdk:"function_runtime"`
+	IsHosted               types.Bool   `tfsdk:"is_hosted"`
+	HermesAppType          types.String `tfsdk:"hermes_app_type"`
 }
 
 func (*Settings) Schema() *schema.SingleNestedBlock {
@@ -113,7 +219,9 @@ func (*Settings) Schema() *schema.SingleNestedBlock {
 		MarkdownDescription: "A group of settings corresponding to the **Settings** section of the app config pages.",
 		Blocks: map[string]schema.Block{
 			"event_subscriptions": (*EventSubscriptions)(nil).schema(),
+			"incoming_webhooks":   (*IncomingWebhooks)(nil).schema(),
 			"interactivity":       (*Interactivity)(nil).schema(),
+			"siws_links":          (*SiwsLinks)(nil).schema(),
 		},
 		Attributes: map[string]schema.Attribute{
 			"allowed_ip_address_ranges": &schema.SetAttribute{
@@ -141,6 +249,24 @@ func (*Settings) Schema() *schema.SingleNestedBlock {
 				MarkdownDescription: "A boolean that specifies whether or not [token rotation](https://api.slack.com/authentication/rotation) is enabled.",
 				Optional:            true,
 			},
+			"function_runtime": &schema.StringAttribute{
+				MarkdownDescription: "A string that specifies where the functions of the app run. Either `remote` for apps hosting their own functions, or `slack` for [apps deployed to Slack](https://api.slack.com/automation/deploy).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("remote", "slack"),
+				},
+			},
+			"is_hosted": &schema.BoolAttribute{
+				MarkdownDescription: "A boolean that specifies whether or not the app is hosted by Slack.",
+				Optional:            true,
+			},
+			"hermes_app_type": &schema.StringAttribute{
+				MarkdownDescription: "A string containing the internal app type that Slack assigns to next-generation apps, such as `remote`. Usually it only needs to be set to keep a manifest exported from Slack as is.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -149,9 +275,66 @@ func (s Settings) Read() manifest.Settings {
 	return manifest.Settings{
 		AllowedIPAddressRanges: typeconv.MustStringSetAsArray(&s.AllowedIPAddressRanges),
 		EventSubscriptions:     typeconv.MapOptionModel[manifest.EventSubscriptions](s.EventSubscriptions),
+		IncomingWebhooks:       typeconv.MapOptionModel[manifest.IncomingWebhooks](s.IncomingWebhooks),
 		Interactivity:          typeconv.MapOptionModel[manifest.Interactivity](s.Interactivity),
 		OrgDeployEnabled:       s.OrgDeployEnabled.ValueBoolPointer(),
 		SocketModeEnabled:      s.SocketModeEnabled.ValueBoolPointer(),
 		TokenRotationEnabled:   s.TokenRotationEnabled.ValueBoolPointer(),
+		FunctionRuntime:        s.FunctionRuntime.ValueStringPointer(),
+		IsHosted:               s.IsHosted.ValueBoolPointer(),
+		SiwsLinks:              typeconv.MapOptionModel[manifest.SiwsLinks](s.SiwsLinks),
+		HermesAppType:          s.HermesAppType.ValueStringPointer(),
+	}
+}
+
+func NewMetadataSubscription(m manifest.MetadataSubscription) MetadataSubscription {
+	return MetadataSubscription{
+		AppID:     types.StringValue(m.AppID),
+		EventType: types.StringValue(m.EventType),
+	}
+}
+
+func NewEventSubscriptions(s manifest.EventSubscriptions) EventSubscriptions {
+	return EventSubscriptions{
+		MetadataSubscriptions: typeconv.MapList(s.MetadataSubscriptions, NewMetadataSubscription),
+		RequestURL:            types.StringPointerValue(s.RequestURL),
+		BotEvents:             typeconv.StringArrayAsSet(s.BotEvents),
+		UserEvents:            typeconv.StringArrayAsSet(s.UserEvents),
+	}
+}
+
+func NewIncomingWebhooks(i manifest.IncomingWebhooks) IncomingWebhooks {
+	return IncomingWebhooks{
+		IncomingWebhooksEnabled: types.BoolPointerValue(i.IncomingWebhooksEnabled),
+	}
+}
+
+func NewSiwsLinks(l manifest.SiwsLinks) SiwsLinks {
+	return SiwsLinks{
+		InitiateURI: types.StringPointerValue(l.InitiateURI),
+	}
+}
+
+func NewInteractivity(i manifest.Interactivity) Interactivity {
+	return Interactivity{
+		IsEnabled:             types.BoolValue(i.IsEnabled),
+		RequestURL:            types.StringPointerValue(i.RequestURL),
+		MessageMenuOptionsURL: types.StringPointerValue(i.MessageMenuOptionsURL),
+	}
+}
+
+func NewSettings(s manifest.Settings) Settings {
+	return Settings{
+		EventSubscriptions:     typeconv.MapOption(s.EventSubscriptions, NewEventSubscriptions),
+		IncomingWebhooks:       typeconv.MapOption(s.IncomingWebhooks, NewIncomingWebhooks),
+		Interactivity:          typeconv.MapOption(s.Interactivity, NewInteractivity),
+		SiwsLinks:              typeconv.MapOption(s.SiwsLinks, NewSiwsLinks),
+		AllowedIPAddressRanges: typeconv.StringArrayAsSet(s.AllowedIPAddressRanges),
+		OrgDeployEnabled:       types.BoolPointerValue(s.OrgDeployEnabled),
+		SocketModeEnabled:      types.BoolPointerValue(s.SocketModeEnabled),
+		TokenRotationEnabled:   types.BoolPointerValue(s.TokenRotationEnabled),
+		FunctionRuntime:        types.StringPointerValue(s.FunctionRuntime),
+		IsHosted:               types.BoolPointerValue(s.IsHosted),
+		HermesAppType:          types.StringPointerValue(s.HermesAppType),
 	}
 }
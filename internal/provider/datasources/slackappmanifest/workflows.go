@@ -0,0 +1,200 @@
+package slackappmanifest
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/myvalidator"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/typeconv"
+)
+
+type Step struct {
+	ID         types.String `tfsdk:"id"`
+	FunctionID types.String `tfsdk:"function_id"`
+	Inputs     types.String `tfsdk:"inputs"`
+}
+
+func (*Step) schema() *schema.ListNestedBlock {
+	return &schema.ListNestedBlock{
+		MarkdownDescription: "An array of settings groups that describe the steps of the workflow, in the order they run.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"id": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the unique identifier of the step within the workflow.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"function_id": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the reference of the function the step runs, such as `slack#/functions/send_message` or `#/functions/my_function`.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"inputs": &schema.StringAttribute{
+					MarkdownDescription: "A JSON-encoded object of the inputs passed to the function, typically built with `jsonencode`. Values can refer to the inputs of the workflow and the outputs of previous steps.",
+					Optional:            true,
+					Validators: []validator.String{
+						myvalidator.JSONObject(),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			myvalidator.UniqueAttribute("id"),
+		},
+	}
+}
+
+func (s Step) decodeInputs() (map[string]json.RawMessage, error) {
+	if s.Inputs.IsNull() || s.Inputs.IsUnknown() {
+		return nil, nil
+	}
+
+	var inputs map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s.Inputs.ValueString()), &inputs); err != nil {
+		return nil, err
+	}
+
+	return inputs, nil
+}
+
+// Read expects the inputs to have been checked by ValidateWorkflowInputs.
+func (s Step) Read() manifest.Step {
+	inputs, _ := s.decodeInputs()
+
+	return manifest.Step{
+		ID:         s.ID.ValueString(),
+		FunctionID: s.FunctionID.ValueString(),
+		Inputs:     inputs,
+	}
+}
+
+type Workflow struct {
+	// Blocks
+	InputParameters []Parameter `tfsdk:"input_parameter"`
+	Steps           []Step      `tfsdk:"step"`
+
+	// Arguments
+	CallbackID  types.String `tfsdk:"callback_id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (*Workflow) Schema() *schema.ListNestedBlock {
+	return &schema.ListNestedBlock{
+		MarkdownDescription: "An array of settings groups that describe the [workflows](https://api.slack.com/automation/workflows) of the app.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"input_parameter": (*Parameter)(nil).schema(
+					"An array of settings groups that describe the input parameters of the workflow.",
+				),
+				"step": (*Step)(nil).schema(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"callback_id": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the `callback_id` of the workflow. Maximum length is 100 characters. Allowed characters: `a-z`, `A-Z`, `0-9` and `_`.",
+					Required:            true,
+					Validators:          callbackIDValidators(),
+				},
+				"title": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the title of the workflow.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"description": &schema.StringAttribute{
+					MarkdownDescription: "A string containing the description of the workflow.",
+					Optional:            true,
+				},
+			},
+		},
+		Validators: []validator.List{
+			myvalidator.UniqueAttribute("callback_id"),
+		},
+	}
+}
+
+func (w Workflow) Read() manifest.Workflow {
+	var inputParameters *manifest.ParameterSet
+	if len(w.InputParameters) > 0 {
+		inputParameters = readParameterSet(w.InputParameters)
+	}
+
+	return manifest.Workflow{
+		Title:           w.Title.ValueString(),
+		Description:     w.Description.ValueStringPointer(),
+		InputParameters: inputParameters,
+		Steps:           typeconv.MapListModel[manifest.Step](w.Steps),
+	}
+}
+
+func ReadWorkflows(workflows []Workflow) map[string]manifest.Workflow {
+	return typeconv.MapKeyedListModel[manifest.Workflow](
+		workflows, func(w Workflow) string {
+			return w.CallbackID.ValueString()
+		},
+	)
+}
+
+// ValidateWorkflowInputs reports the inputs of the steps that are not JSON objects. Validators skip values that are
+// unknown when the configuration is validated, such as ones built from other resources, so they are checked again
+// before the manifest is read.
+func ValidateWorkflowInputs(workflows []Workflow) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	for i, w := range workflows {
+		for j, s := range w.Steps {
+			if _, err := s.decodeInputs(); err != nil {
+				diagnostics.AddAttributeError(
+					path.Root("workflow").AtListIndex(i).AtName("step").AtListIndex(j).AtName("inputs"),
+					"Inputs of the workflow step must be a JSON object.",
+					err.Error(),
+				)
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+func NewStep(s manifest.Step) Step {
+	inputs := types.StringNull()
+	if s.Inputs != nil {
+		// Marshalling a map of raw JSON values cannot fail.
+		bytes, _ := json.Marshal(s.Inputs)
+		inputs = types.StringValue(string(bytes))
+	}
+
+	return Step{
+		ID:         types.StringValue(s.ID),
+		FunctionID: types.StringValue(s.FunctionID),
+		Inputs:     inputs,
+	}
+}
+
+func NewWorkflows(workflows map[string]manifest.Workflow) []Workflow {
+	return typeconv.MapKeyedList(
+		workflows, func(callbackID string, w manifest.Workflow) Workflow {
+			return Workflow{
+				InputParameters: newParameters(w.InputParameters),
+				Steps:           typeconv.MapList(w.Steps, NewStep),
+				CallbackID:      types.StringValue(callbackID),
+				Title:           types.StringValue(w.Title),
+				Description:     types.StringPointerValue(w.Description),
+			}
+		},
+	)
+}
@@ -4,17 +4,26 @@ import (
 	"context"
 	"errors"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/myvalidator"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/resources"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/tokenstore"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/typeconv"
 )
 
 func configureSlackClient(d Model) (*slack.Client, error) {
@@ -34,21 +43,49 @@ func configureSlackClient(d Model) (*slack.Client, error) {
 		refreshToken = d.RefreshToken.ValueString()
 	}
 
-	var client *slack.Client
-	if refreshToken == "" {
-		if appConfigurationToken == "" {
-			return nil, errors.New("either app configuration token or refresh token must be provided")
+	var tokenStore slack.TokenStore
+	if d.TokenStore != nil {
+		if !d.TokenStore.Path.IsNull() {
+			tokenStore = tokenstore.NewFile(d.TokenStore.Path.ValueString())
+		} else {
+			tokenStore = tokenstore.NewCommand(typeconv.MustStringListAsArray(&d.TokenStore.Command))
 		}
+	}
 
-		client = slack.NewClient(appConfigurationToken)
-	} else {
+	var client *slack.Client
+	switch {
+	case refreshToken != "":
 		client = slack.NewClientFromRefreshToken(refreshToken)
+	case appConfigurationToken != "":
+		client = slack.NewClient(appConfigurationToken)
+	case tokenStore != nil:
+		// The tokens are expected to be restored from the store.
+		client = slack.NewClientFromTokenStore(tokenStore)
+	default:
+		return nil, errors.New("either app configuration token, refresh token or token store must be provided")
+	}
+
+	if tokenStore != nil {
+		client = client.WithTokenStore(tokenStore)
 	}
 
 	if baseURL != "" {
 		client = client.WithBaseURL(baseURL)
 	}
 
+	if !d.MaxRetries.IsNull() {
+		client = client.WithMaxRetries(int(d.MaxRetries.ValueInt64()))
+	}
+
+	if !d.MaxBackoff.IsNull() {
+		maxBackoff, err := time.ParseDuration(d.MaxBackoff.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		client = client.WithMaxBackoff(maxBackoff)
+	}
+
 	return client, nil
 }
 
@@ -58,15 +95,38 @@ func configure(d Model) (*common.ProviderContext, error) {
 		return nil, err
 	}
 
+	var backupDirectory *string
+	if d.BackupOnDestroy != nil {
+		backupDirectory = d.BackupOnDestroy.Directory.ValueStringPointer()
+	}
+
 	return &common.ProviderContext{
-		SlackClient: slackClient,
+		SlackClient:                  slackClient,
+		DestroyWarningScopeThreshold: typeconv.Int64PtrAsIntPtr(d.DestroyWarningScopeThreshold.ValueInt64Pointer()),
+		BackupDirectory:              backupDirectory,
+		AppLocks:                     &common.AppLocks{},
 	}, nil
 }
 
+type TokenStoreModel struct {
+	Path    types.String `tfsdk:"path"`
+	Command types.List   `tfsdk:"command"`
+}
+
+type BackupOnDestroyModel struct {
+	Directory types.String `tfsdk:"directory"`
+}
+
 type Model struct {
-	AppConfigurationToken types.String `tfsdk:"app_configuration_token"`
-	RefreshToken          types.String `tfsdk:"refresh_token"`
-	BaseURL               types.String `tfsdk:"base_url"`
+	AppConfigurationToken types.String     `tfsdk:"app_configuration_token"`
+	RefreshToken          types.String     `tfsdk:"refresh_token"`
+	BaseURL               types.String     `tfsdk:"base_url"`
+	MaxRetries            types.Int64      `tfsdk:"max_retries"`
+	MaxBackoff            types.String     `tfsdk:"max_backoff"`
+	TokenStore            *TokenStoreModel `tfsdk:"token_store"`
+
+	DestroyWarningScopeThreshold types.Int64           `tfsdk:"destroy_warning_scope_threshold"`
+	BackupOnDestroy              *BackupOnDestroyModel `tfsdk:"backup_on_destroy"`
 }
 
 type Provider struct {
@@ -107,6 +167,62 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, response
 				MarkdownDescription: "Base URL of the Slack API. Defaults to `https://slack.com/api/`.",
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for a Slack API call that was rate limited or failed with a server or network error. Creating apps and rotating tokens are only retried when rate limited, as they must not take effect twice. Defaults to `5`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_backoff": schema.StringAttribute{
+				MarkdownDescription: "Maximum delay between retries as a duration string such as `30s`, unless Slack asks for a longer one in `Retry-After`. Defaults to `30s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					myvalidator.Duration(),
+				},
+			},
+			"token_store": schema.SingleNestedAttribute{
+				MarkdownDescription: "Where to persist the rotated token pair. Slack refresh tokens can only be used once, so the latest one is saved after every rotation and preferred over `refresh_token` on the next run.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Path to a local JSON file to store the token in. The file is guarded by a `.lock` file next to it, which is held while the token is rotated so that processes sharing the file never rotate the same refresh token, and replaced atomically.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("command")),
+						},
+					},
+					"command": schema.ListAttribute{
+						MarkdownDescription: "Command to store the token with. It is run with an extra `load` argument and must print the stored token as JSON (or nothing), and with `save` to store the token passed as JSON on its standard input. The token is loaded again right before rotating it, but the command cannot be locked across the rotation.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"destroy_warning_scope_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Warn when a plan would destroy an app requesting more bot and user scopes in total than this number, as its installations and tokens cannot be recovered. Once set, apps with `org_deploy_enabled` are warned about regardless of their scopes. Replacements requested with `-replace` or by tainting the app are not warned about, as they are not visible to the provider.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"backup_on_destroy": schema.SingleNestedAttribute{
+				MarkdownDescription: "Export the manifest of an app right before destroying it, so that it can be recreated with the same configuration. If the backup fails, the app is not destroyed.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"directory": schema.StringAttribute{
+						MarkdownDescription: "Path to a local directory to write the backups into, as JSON files named after the app ID and the time of the export. The directory is created if missing.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -136,11 +252,17 @@ func (p *Provider) Configure(
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		datasources.NewSlackAppManifest,
+		datasources.NewSlackAppManifestDecode,
+		datasources.NewSlackApp,
 	}
 }
 
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewSlackApp,
+		resources.NewSlashCommand,
+		resources.NewShortcut,
+		resources.NewEventSubscription,
+		resources.NewScope,
 	}
 }
@@ -0,0 +1,100 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/slacktest"
+)
+
+const testToken = "xoxe.xoxp-test"
+
+// The acceptance tests need `TF_ACC=1` and a Terraform CLI, but talk to slacktest.Server instead of Slack.
+var protoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"slackapp": providerserver.NewProtocol6WithError(provider.New("test")()),
+}
+
+// newSlackServer starts a fake Slack API accepting the token of the provider configured by providerConfig.
+func newSlackServer(t *testing.T) *slacktest.Server {
+	t.Helper()
+
+	server := slacktest.NewServer()
+	t.Cleanup(server.Close)
+
+	server.AddToken(testToken, time.Now().Add(time.Hour))
+
+	return server
+}
+
+// providerConfig configures the provider against the fake Slack API, followed by the rest of the configuration.
+func providerConfig(server *slacktest.Server, config string) string {
+	return fmt.Sprintf(
+		`
+provider "slackapp" {
+  base_url                = %q
+  app_configuration_token = %q
+}
+`, server.BaseURL(), testToken,
+	) + config
+}
+
+// attribute returns an attribute of the resource in the state.
+func attribute(s *terraform.State, address string, name string) (string, error) {
+	resource, ok := s.RootModule().Resources[address]
+	if !ok {
+		return "", fmt.Errorf("%s is not in the state", address)
+	}
+
+	value, ok := resource.Primary.Attributes[name]
+	if !ok {
+		return "", fmt.Errorf("%s has no %s", address, name)
+	}
+
+	return value, nil
+}
+
+// checkManifest checks that the app of the resource exists in Slack with the manifest.
+func checkManifest(server *slacktest.Server, address string, want string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		appID, err := attribute(s, address, "id")
+		if err != nil {
+			return err
+		}
+
+		return checkAppManifest(server, appID, want)(s)
+	}
+}
+
+// checkAppManifest checks that the app has the manifest in Slack.
+func checkAppManifest(server *slacktest.Server, appID string, want string) func(*terraform.State) error {
+	return func(*terraform.State) error {
+		got, ok := server.Manifest(appID)
+		if !ok {
+			return fmt.Errorf("the app %s does not exist", appID)
+		}
+
+		if equal, err := manifest.SemanticallyEqual(got, want); err != nil || !equal {
+			return fmt.Errorf("the manifest of the app is %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+// checkNoApps checks that every app has been deleted from Slack.
+func checkNoApps(server *slacktest.Server) func(*terraform.State) error {
+	return func(*terraform.State) error {
+		if appIDs := server.AppIDs(); len(appIDs) > 0 {
+			return fmt.Errorf("the apps %v were not deleted", appIDs)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,343 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+const appSliceDescription = "It owns a part of the manifest of an existing app, so that separate configurations can " +
+	"contribute to the same app. Changes are made by exporting the manifest, modifying it and updating the app, one " +
+	"at a time per app within a Terraform run. Slack offers no way to detect concurrent updates, so separate runs " +
+	"changing the same app must not be applied at the same time, or one of their changes may be lost. Do not use it " +
+	"for apps whose manifest is managed by `slackapp_application`, as they would overwrite each other."
+
+// appSlice is embedded into the resources owning a part of the manifest of an existing app.
+type appSlice struct {
+	ctx *common.ProviderContext
+}
+
+func (r *appSlice) Configure(
+	_ context.Context,
+	request resource.ConfigureRequest,
+	response *resource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerContext, ok := request.ProviderData.(*common.ProviderContext)
+	if !ok {
+		response.Diagnostics.AddError(
+			"The ctx did not configured properly.",
+			"request.ProviderData.(type) != *ctx.ConfiguredProvider",
+		)
+
+		return
+	}
+
+	r.ctx = providerContext
+}
+
+// export returns the current manifest of the app, which is nil if the app does not exist anymore.
+func (r *appSlice) export(ctx context.Context, diagnostics *diag.Diagnostics, appID string) (*manifest.App, bool) {
+	apiResponse, err := r.ctx.SlackClient.AppsManifestExport(
+		ctx, slack.AppsManifestExportRequest{
+			AppID: appID,
+		},
+	)
+	if errors.Is(err, slack.ErrAppNotFound) {
+		return nil, true
+	}
+
+	if err != nil {
+		diagnostics.AddError("Failed to export the manifest of the Slack App.", common.SlackErrorDetail(err))
+
+		return nil, false
+	}
+
+	if apiResponse.Manifest == nil {
+		diagnostics.AddError("Slack API returned empty manifest.", "apps.manifest.export returned ok but no manifest payload")
+
+		return nil, false
+	}
+
+	return apiResponse.Manifest, true
+}
+
+// modify applies the change to the current manifest of the app and updates the app with it, while holding the lock
+// of the app so that the resources sharing it do not overwrite each other. The app is left as is if nothing changed.
+// A missing app is an error unless the part is being removed, as it is gone with the app then.
+func (r *appSlice) modify(
+	ctx context.Context,
+	diagnostics *diag.Diagnostics,
+	appID string,
+	removing bool,
+	change func(app *manifest.App) error,
+) bool {
+	unlock := r.ctx.AppLocks.Lock(appID)
+	defer unlock()
+
+	app, ok := r.export(ctx, diagnostics, appID)
+	if !ok {
+		return false
+	}
+
+	if app == nil && removing {
+		return true
+	}
+
+	if app == nil {
+		diagnostics.AddAttributeError(
+			path.Root("app_id"),
+			"The Slack App was not found.",
+			fmt.Sprintf("The app `%s` does not exist, or the token is not allowed to manage it.", appID),
+		)
+
+		return false
+	}
+
+	before, err := json.Marshal(app)
+	if err != nil {
+		diagnostics.AddError("Failed to re-serialize the JSON manifest.", err.Error())
+
+		return false
+	}
+
+	if err := change(app); err != nil {
+		diagnostics.AddError("Failed to modify the manifest of the Slack App.", err.Error())
+
+		return false
+	}
+
+	after, err := json.Marshal(app)
+	if err != nil {
+		diagnostics.AddError("Failed to re-serialize the JSON manifest.", err.Error())
+
+		return false
+	}
+
+	if string(before) == string(after) {
+		return true
+	}
+
+	apiResponse, err := r.ctx.SlackClient.AppsManifestUpdate(
+		ctx, slack.AppsManifestUpdateRequest{
+			AppID:    appID,
+			Manifest: string(after),
+		},
+	)
+	if err != nil {
+		diagnostics.AddError("Failed to update the Slack App using API.", common.SlackErrorDetail(err))
+
+		return false
+	}
+
+	if apiResponse.PermissionsUpdated {
+		diagnostics.AddWarning(
+			"The Slack App needs to be reinstalled.",
+			"The permissions of the app were updated. A workspace admin has to reinstall the app for the changes to take effect.",
+		)
+	}
+
+	tflog.Debug(ctx, "Updated the manifest of the Slack App.", map[string]any{"id": appID})
+
+	return true
+}
+
+// splitImportID splits the import ID into the app ID and the given number of parts identifying the slice, the last
+// of which may contain the separator.
+func splitImportID(diagnostics *diag.Diagnostics, id string, format string, parts int) ([]string, bool) {
+	values := strings.SplitN(id, ":", parts+1)
+	if len(values) != parts+1 || slices.Contains(values, "") {
+		diagnostics.AddError(
+			"Invalid import ID.",
+			fmt.Sprintf("Expected `%s`, got `%s`.", format, id),
+		)
+
+		return nil, false
+	}
+
+	return values, true
+}
+
+// validSliceType reports whether the type of a scope or an event from an import ID is either bot or user.
+func validSliceType(diagnostics *diag.Diagnostics, value string) bool {
+	if value != "bot" && value != "user" {
+		diagnostics.AddError("Invalid import ID.", fmt.Sprintf("The type must be either `bot` or `user`, got `%s`.", value))
+
+		return false
+	}
+
+	return true
+}
+
+// removeString returns the values without the value, which are nil if nothing is left so that the field is omitted.
+func removeString(values []string, value string) []string {
+	var removed []string
+	for _, v := range values {
+		if v != value {
+			removed = append(removed, v)
+		}
+	}
+
+	return removed
+}
+
+// stringSetModel is implemented by the models of the resources adding a string to a list of the manifest, which is
+// either of the bot or of users.
+type stringSetModel interface {
+	// parts returns the app ID, the type and the string.
+	parts() (appID string, valueType string, value string)
+	setID(id types.String)
+}
+
+// stringSetSlice implements the resources adding a string to a list of the manifest, given the accessors of the list.
+// The resources only define their metadata, schema and accessors, and every argument requires replacement.
+type stringSetSlice[M any, PM interface {
+	*M
+	stringSetModel
+}] struct {
+	appSlice
+
+	// attribute is the name of the attribute holding the string.
+	attribute string
+	// importFormat describes the import ID, such as `<app_id>:<type>:<scope>`.
+	importFormat string
+	// alreadyAdded is the format of the error for a string already in the list, given its type and itself.
+	alreadyAdded string
+
+	get func(app *manifest.App, valueType string) []string
+	set func(app *manifest.App, valueType string, values []string)
+}
+
+func (r *stringSetSlice[M, PM]) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	var data M
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	appID, valueType, value := PM(&data).parts()
+	ok := r.modify(
+		ctx, &response.Diagnostics, appID, false, func(app *manifest.App) error {
+			// Deleting the resource removes the string, so it must not take over one added by someone else.
+			values := r.get(app, valueType)
+			if slices.Contains(values, value) {
+				return fmt.Errorf(r.alreadyAdded, valueType, value)
+			}
+
+			r.set(app, valueType, append(values, value))
+
+			return nil
+		},
+	)
+	if !ok {
+		return
+	}
+
+	PM(&data).setID(types.StringValue(appID + ":" + valueType + ":" + value))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *stringSetSlice[M, PM]) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	var data M
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	appID, valueType, value := PM(&data).parts()
+
+	app, ok := r.export(ctx, &response.Diagnostics, appID)
+	if !ok {
+		return
+	}
+
+	if app == nil || !slices.Contains(r.get(app, valueType), value) {
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *stringSetSlice[M, PM]) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	// Every argument requires replacement, so there is nothing to update.
+	var data M
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *stringSetSlice[M, PM]) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	var data M
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	appID, valueType, value := PM(&data).parts()
+	r.modify(
+		ctx, &response.Diagnostics, appID, true, func(app *manifest.App) error {
+			if values := r.get(app, valueType); slices.Contains(values, value) {
+				r.set(app, valueType, removeString(values, value))
+			}
+
+			return nil
+		},
+	)
+}
+
+func (r *stringSetSlice[M, PM]) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	values, ok := splitImportID(&response.Diagnostics, request.ID, r.importFormat, 2)
+	if !ok || !validSliceType(&response.Diagnostics, values[1]) {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("app_id"), values[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("type"), values[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(r.attribute), values[2])...)
+}
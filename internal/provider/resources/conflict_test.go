@@ -0,0 +1,86 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/customtypes"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/slacktest"
+)
+
+func TestCheckConflict(t *testing.T) {
+	stateManifest := `{"_metadata":{"major_version":1},"display_information":{"name":"before"}}`
+
+	tests := []struct {
+		name         string
+		liveManifest string
+		want         bool
+		wantDetail   string
+	}{
+		{
+			// Slack trims _metadata from the exported manifest.
+			name:         "unchanged",
+			liveManifest: `{"display_information":{"name":"before"}}`,
+			want:         true,
+		},
+		{
+			name:         "changed outside of Terraform",
+			liveManifest: `{"display_information":{"name":"edited"},"settings":{"socket_mode_enabled":true}}`,
+			want:         false,
+			wantDetail: "- `/display_information/name`: \"before\" -> \"edited\"\n" +
+				"- `/settings`: (absent) -> {\"socket_mode_enabled\":true}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				server := slacktest.NewServer()
+				defer server.Close()
+
+				server.AddToken("xoxe.xoxp-test", time.Now().Add(time.Hour))
+
+				appID, err := server.CreateApp(tt.liveManifest)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				r := &SlackApp{
+					ctx: &common.ProviderContext{
+						SlackClient: slack.NewClient("xoxe.xoxp-test").WithBaseURL(server.BaseURL()),
+					},
+				}
+
+				var diagnostics diag.Diagnostics
+
+				got := r.checkConflict(
+					context.Background(),
+					&diagnostics,
+					SlackAppModel{ID: types.StringValue(appID), Manifest: customtypes.NewManifestValue(stateManifest)},
+				)
+				if got != tt.want {
+					t.Fatalf("checkConflict() = %t, want %t: %v", got, tt.want, diagnostics)
+				}
+
+				if tt.want {
+					if diagnostics.HasError() {
+						t.Errorf("unexpected diagnostics: %v", diagnostics)
+					}
+
+					return
+				}
+
+				if diagnostics.ErrorsCount() != 1 || !strings.Contains(diagnostics.Errors()[0].Detail(), tt.wantDetail) {
+					t.Errorf("the changed fields are not reported as\n%s\ngot %v", tt.wantDetail, diagnostics)
+				}
+			},
+		)
+	}
+}
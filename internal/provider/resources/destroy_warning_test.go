@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/customtypes"
+)
+
+func TestWarnDestroy(t *testing.T) {
+	three := 3
+
+	tests := []struct {
+		name               string
+		threshold          *int
+		manifest           string
+		deletionProtection bool
+		want               string
+	}{
+		{
+			name:      "below the threshold",
+			threshold: &three,
+			manifest:  `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write"],"user":["users:read"]}}}`,
+		},
+		{
+			name:      "at the threshold",
+			threshold: &three,
+			manifest:  `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write","commands"],"user":["users:read"]}}}`,
+		},
+		{
+			name:      "above the threshold",
+			threshold: &three,
+			manifest:  `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write","commands"],"user":["users:read","search:read"]}}}`,
+			want:      "while it requests 4 scopes, more than 3.",
+		},
+		{
+			name:      "deployed to organizations",
+			threshold: &three,
+			manifest:  `{"display_information":{"name":"app"},"settings":{"org_deploy_enabled":true}}`,
+			want:      "while it is deployed to organizations.",
+		},
+		{
+			name:      "deployed to organizations and above the threshold",
+			threshold: &three,
+			manifest:  `{"display_information":{"name":"app"},"settings":{"org_deploy_enabled":true},"oauth_config":{"scopes":{"bot":["a","b","c","d"]}}}`,
+			want:      "while it is deployed to organizations and it requests 4 scopes, more than 3.",
+		},
+		{
+			name:      "without the threshold",
+			threshold: nil,
+			manifest:  `{"display_information":{"name":"app"},"settings":{"org_deploy_enabled":true},"oauth_config":{"scopes":{"bot":["a","b","c","d"]}}}`,
+		},
+		{
+			// Delete fails anyway, with its own diagnostic.
+			name:               "protected from deletion",
+			threshold:          &three,
+			manifest:           `{"display_information":{"name":"app"},"settings":{"org_deploy_enabled":true}}`,
+			deletionProtection: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				ctx := context.Background()
+
+				r := &SlackApp{ctx: &common.ProviderContext{DestroyWarningScopeThreshold: tt.threshold}}
+
+				var schemaResponse resource.SchemaResponse
+
+				r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				state := tfsdk.State{Schema: schemaResponse.Schema}
+				diags := state.Set(
+					ctx, &SlackAppModel{
+						Manifest:           customtypes.NewManifestValue(tt.manifest),
+						ConflictDetection:  types.BoolNull(),
+						DeletionProtection: types.BoolValue(tt.deletionProtection),
+						ID:                 types.StringValue("A0123"),
+						Credentials:        types.ObjectNull(credentialsAttributeTypes),
+						OauthAuthorizeURL:  types.StringNull(),
+						PermissionsUpdated: types.BoolValue(false),
+					},
+				)
+				if diags.HasError() {
+					t.Fatal(diags)
+				}
+
+				// Destroying is planned as a null object.
+				plan := tfsdk.Plan{
+					Schema: schemaResponse.Schema,
+					Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+				}
+
+				response := resource.ModifyPlanResponse{Plan: plan}
+
+				r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &response)
+
+				if response.Diagnostics.HasError() {
+					t.Fatal(response.Diagnostics)
+				}
+
+				if tt.want == "" {
+					if len(response.Diagnostics) > 0 {
+						t.Errorf("unexpected diagnostics: %v", response.Diagnostics)
+					}
+
+					return
+				}
+
+				warnings := response.Diagnostics.Warnings()
+				if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), tt.want) {
+					t.Errorf("the diagnostics are %v, want a warning containing %q", response.Diagnostics, tt.want)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestWarnPermissionChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		before  string
+		after   string
+		changes []string
+	}{
+		{
+			name:    "added bot scope",
+			before:  `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write"]}}}`,
+			after:   `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write","commands"]}}}`,
+			changes: []string{"- added bot scopes: commands"},
+		},
+		{
+			name:    "removed bot scope",
+			before:  `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write","commands"]}}}`,
+			after:   `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["commands"]}}}`,
+			changes: []string{"- removed bot scopes: chat:write"},
+		},
+		{
+			name:   "added and removed user scopes",
+			before: `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"user":["search:read"]}}}`,
+			after:  `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"user":["users:read","channels:read"]}}}`,
+			changes: []string{
+				"- added user scopes: channels:read, users:read",
+				"- removed user scopes: search:read",
+			},
+		},
+		{
+			name:   "unchanged scopes in another order",
+			before: `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write","commands"]}}}`,
+			after:  `{"display_information":{"name":"before"},"oauth_config":{"scopes":{"bot":["commands","chat:write"]}}}`,
+		},
+		{
+			name:   "without oauth_config",
+			before: `{"display_information":{"name":"app"}}`,
+			after:  `{"display_information":{"name":"renamed"}}`,
+		},
+		{
+			name:    "oauth_config added",
+			before:  `{"display_information":{"name":"app"}}`,
+			after:   `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write"]}}}`,
+			changes: []string{"- added bot scopes: chat:write"},
+		},
+		{
+			name:    "oauth_config removed",
+			before:  `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"user":["users:read"]}}}`,
+			after:   `{"display_information":{"name":"app"}}`,
+			changes: []string{"- removed user scopes: users:read"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var diagnostics diag.Diagnostics
+
+				warnPermissionChanges(&diagnostics, tt.before, tt.after)
+
+				if len(tt.changes) == 0 {
+					if len(diagnostics) > 0 {
+						t.Errorf("unexpected diagnostics: %v", diagnostics)
+					}
+
+					return
+				}
+
+				if diagnostics.WarningsCount() != 1 || diagnostics.HasError() {
+					t.Fatalf("the diagnostics are %v, want a warning", diagnostics)
+				}
+
+				want := strings.Join(tt.changes, "\n")
+				if detail := diagnostics.Warnings()[0].Detail(); !strings.Contains(detail, ":\n"+want+"\n\n") {
+					t.Errorf("the warning\n%s\ndoes not list exactly\n%s", detail, want)
+				}
+			},
+		)
+	}
+}
@@ -3,31 +3,53 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/backup"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/customtypes"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/hclgen"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources/slackappmanifest"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
 )
 
 type SlackAppModel struct {
 	// Arguments
-	Manifest types.String `tfsdk:"manifest"`
+	Manifest           customtypes.Manifest `tfsdk:"manifest"`
+	ConflictDetection  types.Bool           `tfsdk:"conflict_detection"`
+	DeletionProtection types.Bool           `tfsdk:"deletion_protection"`
 
 	// Attributes
-	ID                types.String `tfsdk:"id"`
-	Credentials       types.Object `tfsdk:"credentials"`
-	OauthAuthorizeURL types.String `tfsdk:"oauth_authorize_url"`
+	ID                 types.String `tfsdk:"id"`
+	Credentials        types.Object `tfsdk:"credentials"`
+	OauthAuthorizeURL  types.String `tfsdk:"oauth_authorize_url"`
+	PermissionsUpdated types.Bool   `tfsdk:"permissions_updated"`
+}
+
+var credentialsAttributeTypes = map[string]attr.Type{
+	"client_id":          types.StringType,
+	"client_secret":      types.StringType,
+	"verification_token": types.StringType,
+	"signing_secret":     types.StringType,
 }
 
 type SlackApp struct {
@@ -51,8 +73,19 @@ func (r *SlackApp) Schema(_ context.Context, _ resource.SchemaRequest, response
 		Attributes: map[string]schema.Attribute{
 			// Arguments
 			"manifest": &schema.StringAttribute{
-				MarkdownDescription: "A JSON app manifest encoded as a string. This manifest must use a valid [app manifest schema - read our guide to creating one](https://api.slack.com/reference/manifests#fields).",
+				MarkdownDescription: "A JSON or YAML app manifest encoded as a string. YAML manifests are converted to JSON before being sent to Slack. This manifest must use a valid [app manifest schema - read our guide to creating one](https://api.slack.com/reference/manifests#fields).",
 				Required:            true,
+				CustomType:          customtypes.ManifestType{},
+			},
+			"deletion_protection": &schema.BoolAttribute{
+				MarkdownDescription: "Whether to refuse deleting the app. Deleting an app revokes its credentials, installations and every token issued to it, which cannot be recovered. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"conflict_detection": &schema.BoolAttribute{
+				MarkdownDescription: "Whether to check that the app has not been changed outside of Terraform, e.g. in the app configuration pages, since the last refresh before updating it. If it has, the update fails with the changed fields instead of overwriting them.",
+				Optional:            true,
 			},
 
 			// Attributes
@@ -67,12 +100,7 @@ func (r *SlackApp) Schema(_ context.Context, _ resource.SchemaRequest, response
 				MarkdownDescription: "Secrets and credentials for the app.",
 				Computed:            true,
 				Sensitive:           true,
-				AttributeTypes: map[string]attr.Type{
-					"client_id":          types.StringType,
-					"client_secret":      types.StringType,
-					"verification_token": types.StringType,
-					"signing_secret":     types.StringType,
-				},
+				AttributeTypes:      credentialsAttributeTypes,
 				PlanModifiers: []planmodifier.Object{
 					objectplanmodifier.UseStateForUnknown(),
 				},
@@ -84,6 +112,10 @@ func (r *SlackApp) Schema(_ context.Context, _ resource.SchemaRequest, response
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"permissions_updated": &schema.BoolAttribute{
+				MarkdownDescription: "Whether the last update of the manifest changed the permissions of the app. If true, the app has to be reinstalled to the workspaces for the changes to take effect.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -110,6 +142,138 @@ func (r *SlackApp) Configure(
 	r.ctx = providerContext
 }
 
+func (r *SlackApp) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	// Nothing to validate when destroying, but destroying some apps deserves a warning.
+	if request.Plan.Raw.IsNull() {
+		r.warnDestroy(ctx, request, response)
+
+		return
+	}
+
+	var data SlackAppModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Manifest.IsUnknown() || data.Manifest.IsNull() {
+		return
+	}
+
+	manifestJSON, ok := manifestAsJSON(&response.Diagnostics, data.Manifest)
+	if !ok {
+		return
+	}
+
+	if !request.State.Raw.IsNull() {
+		var state SlackAppModel
+
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// The manifest in the state has already been accepted by Slack, so spare the API call on no-op plans.
+		if equal, err := manifest.SemanticallyEqual(state.Manifest.ValueString(), manifestJSON); err == nil && equal {
+			return
+		}
+
+		warnPermissionChanges(&response.Diagnostics, state.Manifest.ValueString(), manifestJSON)
+
+		response.Diagnostics.Append(
+			response.Plan.SetAttribute(
+				ctx,
+				path.Root("oauth_authorize_url"),
+				updatedOauthAuthorizeURL(state, manifestJSON),
+			)...,
+		)
+	}
+
+	// The manifest can be validated only after the provider is configured.
+	if r.ctx == nil {
+		return
+	}
+
+	var appID *string
+	if !data.ID.IsUnknown() && !data.ID.IsNull() {
+		appID = data.ID.ValueStringPointer()
+	}
+
+	_, err := r.ctx.SlackClient.AppsManifestValidate(
+		ctx, slack.AppsManifestValidateRequest{
+			AppID:    appID,
+			Manifest: manifestJSON,
+		},
+	)
+	if err != nil {
+		r.handleSlackErrorInDiag(&response.Diagnostics, "validate", manifestJSON, err)
+
+		return
+	}
+}
+
+// warnDestroy warns about destroying an app that is deployed to organizations or requests many scopes, which would be
+// hard to set up again.
+func (r *SlackApp) warnDestroy(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	if r.ctx == nil || r.ctx.DestroyWarningScopeThreshold == nil || request.State.Raw.IsNull() {
+		return
+	}
+
+	var data SlackAppModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete fails anyway, with its own diagnostic.
+	if data.DeletionProtection.ValueBool() {
+		return
+	}
+
+	app, err := manifest.Parse(data.Manifest.ValueString())
+	if err != nil {
+		return
+	}
+
+	var reasons []string
+
+	if app.OrgDeployEnabled() {
+		reasons = append(reasons, "it is deployed to organizations")
+	}
+
+	scopeCount := len(app.BotScopes()) + len(app.UserScopes())
+	if threshold := *r.ctx.DestroyWarningScopeThreshold; scopeCount > threshold {
+		reasons = append(reasons, fmt.Sprintf("it requests %d scopes, more than %d", scopeCount, threshold))
+	}
+
+	if len(reasons) == 0 {
+		return
+	}
+
+	response.Diagnostics.AddWarning(
+		fmt.Sprintf("The Slack App %s will be destroyed.", data.ID.ValueString()),
+		fmt.Sprintf(
+			"The app is about to be destroyed while %s. "+
+				"Its credentials, installations and tokens cannot be recovered. "+
+				"Set `deletion_protection` to prevent this.",
+			strings.Join(reasons, " and "),
+		),
+	)
+}
+
 func (r *SlackApp) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data SlackAppModel
 
@@ -119,25 +283,25 @@ func (r *SlackApp) Create(ctx context.Context, request resource.CreateRequest, r
 		return
 	}
 
+	manifestJSON, ok := manifestAsJSON(&response.Diagnostics, data.Manifest)
+	if !ok {
+		return
+	}
+
 	apiResponse, err := r.ctx.SlackClient.AppsManifestCreate(
 		ctx, slack.AppsManifestCreateRequest{
-			Manifest: data.Manifest.ValueString(),
+			Manifest: manifestJSON,
 		},
 	)
 	if err != nil {
-		r.handleSlackErrorInDiag(&response.Diagnostics, err)
+		r.handleSlackErrorInDiag(&response.Diagnostics, "create", manifestJSON, err)
 
 		return
 	}
 
 	data.ID = types.StringValue(apiResponse.AppID)
 	data.Credentials = types.ObjectValueMust(
-		map[string]attr.Type{
-			"client_id":          types.StringType,
-			"client_secret":      types.StringType,
-			"verification_token": types.StringType,
-			"signing_secret":     types.StringType,
-		},
+		credentialsAttributeTypes,
 		map[string]attr.Value{
 			"client_id":          types.StringValue(apiResponse.Credentials.ClientID),
 			"client_secret":      types.StringValue(apiResponse.Credentials.ClientSecret),
@@ -146,6 +310,7 @@ func (r *SlackApp) Create(ctx context.Context, request resource.CreateRequest, r
 		},
 	)
 	data.OauthAuthorizeURL = types.StringValue(apiResponse.OauthAuthorizeURL)
+	data.PermissionsUpdated = types.BoolValue(false)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -164,8 +329,16 @@ func (r *SlackApp) Read(ctx context.Context, request resource.ReadRequest, respo
 			AppID: data.ID.ValueString(),
 		},
 	)
+	if errors.Is(err, slack.ErrAppNotFound) {
+		// The app has been deleted outside of Terraform, so plan to create it again.
+		tflog.Warn(ctx, "The Slack App was not found, removing it from the state.", map[string]any{"id": data.ID.ValueString()})
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
 	if err != nil {
-		r.handleSlackErrorInDiag(&response.Diagnostics, err)
+		r.handleSlackErrorInDiag(&response.Diagnostics, "read", "", err)
 
 		return
 	}
@@ -181,11 +354,11 @@ func (r *SlackApp) Read(ctx context.Context, request resource.ReadRequest, respo
 	// Slack API trims _metadata from the manifest on applying.
 	// To avoid unnecessary parsing and ignore diffs, only unmarshal when replacement is needed.
 	if hasLocalManifest && apiResponse.Manifest.Metadata == nil {
-		var newManifest manifest.App
-		if err := json.Unmarshal([]byte(data.Manifest.ValueString()), &newManifest); err != nil {
+		newManifest, err := manifest.Parse(data.Manifest.ValueString())
+		if err != nil {
 			response.Diagnostics.AddAttributeError(
 				path.Root("manifest"),
-				"Manifest must be a valid JSON.",
+				"Manifest must be a valid JSON or YAML.",
 				err.Error(),
 			)
 
@@ -202,7 +375,16 @@ func (r *SlackApp) Read(ctx context.Context, request resource.ReadRequest, respo
 		return
 	}
 
-	data.Manifest = types.StringValue(string(manifestJSON))
+	data.Manifest = customtypes.NewManifestValue(string(manifestJSON))
+
+	// Imported apps have never been updated by this provider.
+	if data.PermissionsUpdated.IsNull() {
+		data.PermissionsUpdated = types.BoolValue(false)
+	}
+
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -217,24 +399,121 @@ func (r *SlackApp) Update(ctx context.Context, request resource.UpdateRequest, r
 		return
 	}
 
-	_, err := r.ctx.SlackClient.AppsManifestUpdate(
+	manifestJSON, ok := manifestAsJSON(&response.Diagnostics, after.Manifest)
+	if !ok {
+		return
+	}
+
+	// Only the arguments of the provider itself changed, such as deletion_protection, so Slack is left untouched.
+	if equal, err := manifest.SemanticallyEqual(before.Manifest.ValueString(), manifestJSON); err == nil && equal {
+		after.Credentials = before.Credentials
+		after.OauthAuthorizeURL = before.OauthAuthorizeURL
+		after.PermissionsUpdated = before.PermissionsUpdated
+
+		response.Diagnostics.Append(response.State.Set(ctx, &after)...)
+
+		return
+	}
+
+	if after.ConflictDetection.ValueBool() && !r.checkConflict(ctx, &response.Diagnostics, before) {
+		return
+	}
+
+	apiResponse, err := r.ctx.SlackClient.AppsManifestUpdate(
 		ctx, slack.AppsManifestUpdateRequest{
 			AppID:    after.ID.ValueString(),
-			Manifest: after.Manifest.ValueString(),
+			Manifest: manifestJSON,
 		},
 	)
 	if err != nil {
-		r.handleSlackErrorInDiag(&response.Diagnostics, err)
+		r.handleSlackErrorInDiag(&response.Diagnostics, "update", manifestJSON, err)
 
 		return
 	}
 
 	after.Credentials = before.Credentials
-	after.OauthAuthorizeURL = before.OauthAuthorizeURL
+	after.OauthAuthorizeURL = updatedOauthAuthorizeURL(before, manifestJSON)
+	after.PermissionsUpdated = types.BoolValue(apiResponse.PermissionsUpdated)
+
+	if apiResponse.PermissionsUpdated {
+		response.Diagnostics.AddWarning(
+			"The Slack App needs to be reinstalled.",
+			"The permissions of the app were updated. A workspace admin has to reinstall the app for the changes to take effect.",
+		)
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &after)...)
 }
 
+// checkConflict compares the live manifest with the one in the state, and reports false with the changed fields if
+// someone has edited the app since the last refresh.
+func (r *SlackApp) checkConflict(ctx context.Context, diagnostics *diag.Diagnostics, state SlackAppModel) bool {
+	apiResponse, err := r.ctx.SlackClient.AppsManifestExport(
+		ctx, slack.AppsManifestExportRequest{
+			AppID: state.ID.ValueString(),
+		},
+	)
+	if err != nil {
+		r.handleSlackErrorInDiag(diagnostics, "read", "", err)
+
+		return false
+	}
+
+	if apiResponse.Manifest == nil {
+		diagnostics.AddError("Slack API returned empty manifest.", "apps.manifest.export returned ok but no manifest payload")
+
+		return false
+	}
+
+	prior, err := manifest.Parse(state.Manifest.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("manifest"), "Manifest must be a valid JSON or YAML.", err.Error())
+
+		return false
+	}
+
+	// Slack API trims _metadata from the manifest, which cannot be edited outside of Terraform anyway.
+	live := apiResponse.Manifest
+	if live.Metadata == nil {
+		live.Metadata = prior.Metadata
+	}
+
+	liveJSON, err := live.ToJsonString()
+	if err != nil {
+		diagnostics.AddError("Failed to re-serialize the JSON manifest.", err.Error())
+
+		return false
+	}
+
+	differences, err := manifest.Diff(state.Manifest.ValueString(), liveJSON)
+	if err != nil {
+		diagnostics.AddError("Failed to compare the manifest with the live one.", err.Error())
+
+		return false
+	}
+
+	if len(differences) == 0 {
+		return true
+	}
+
+	lines := make([]string, 0, len(differences))
+	for _, difference := range differences {
+		lines = append(lines, "- "+difference.String())
+	}
+
+	diagnostics.AddAttributeError(
+		path.Root("manifest"),
+		"The Slack App was changed outside of Terraform.",
+		fmt.Sprintf(
+			"The live manifest differs from the one in the state since the last refresh (state -> live):\n%s\n\n"+
+				"Refresh and review the plan again to incorporate or overwrite the changes.",
+			strings.Join(lines, "\n"),
+		),
+	)
+
+	return false
+}
+
 func (r *SlackApp) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	var data SlackAppModel
 
@@ -244,33 +523,362 @@ func (r *SlackApp) Delete(ctx context.Context, request resource.DeleteRequest, r
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		response.Diagnostics.AddError(
+			"The Slack App is protected from deletion.",
+			fmt.Sprintf(
+				"The app %s cannot be deleted while `deletion_protection` is enabled. "+
+					"Set it to false and apply before destroying the app.",
+				data.ID.ValueString(),
+			),
+		)
+
+		return
+	}
+
+	if directory := r.ctx.BackupDirectory; directory != nil {
+		if !r.backUp(ctx, &response.Diagnostics, *directory, data) {
+			return
+		}
+	}
+
 	_, err := r.ctx.SlackClient.AppsManifestDelete(
 		ctx, slack.AppsManifestDeleteRequest{
 			AppID: data.ID.ValueString(),
 		},
 	)
+	if errors.Is(err, slack.ErrAppNotFound) {
+		// Already deleted outside of Terraform.
+		return
+	}
+
 	if err != nil {
-		r.handleSlackErrorInDiag(&response.Diagnostics, err)
+		r.handleSlackErrorInDiag(&response.Diagnostics, "delete", "", err)
 
 		return
 	}
 }
 
+// backUp exports the manifest of the app into the directory before it gets deleted, and reports false if it failed.
+func (r *SlackApp) backUp(
+	ctx context.Context,
+	diagnostics *diag.Diagnostics,
+	directory string,
+	data SlackAppModel,
+) bool {
+	apiResponse, err := r.ctx.SlackClient.AppsManifestExport(
+		ctx, slack.AppsManifestExportRequest{
+			AppID: data.ID.ValueString(),
+		},
+	)
+	if errors.Is(err, slack.ErrAppNotFound) {
+		// Nothing is left to back up, and Delete treats the app as already deleted.
+		return true
+	}
+
+	if err != nil {
+		r.handleSlackErrorInDiag(diagnostics, "back up", "", err)
+
+		return false
+	}
+
+	state := backup.State{
+		Manifest:          data.Manifest.ValueString(),
+		OauthAuthorizeURL: data.OauthAuthorizeURL.ValueString(),
+	}
+
+	if clientID, ok := data.Credentials.Attributes()["client_id"].(types.String); ok {
+		state.ClientID = clientID.ValueString()
+	}
+
+	backupPath, err := backup.Write(
+		directory, backup.Backup{
+			AppID:      data.ID.ValueString(),
+			ExportedAt: time.Now(),
+			Manifest:   apiResponse.Manifest,
+			State:      state,
+		},
+	)
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to back up the Slack App.",
+			fmt.Sprintf("The app was not deleted, as its manifest could not be written into %s: %s", directory, err),
+		)
+
+		return false
+	}
+
+	tflog.Info(ctx, "Backed up the Slack App before deleting it.", map[string]any{"id": data.ID.ValueString(), "path": backupPath})
+
+	return true
+}
+
 func (r *SlackApp) ImportState(
 	ctx context.Context,
 	request resource.ImportStateRequest,
 	response *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	appID, clientID, hasClientID := strings.Cut(request.ID, ":")
+	if appID == "" || (hasClientID && clientID == "") {
+		response.Diagnostics.AddError(
+			"Invalid import ID.",
+			fmt.Sprintf("Expected `<app_id>` or `<app_id>:<client_id>`, got `%s`.", request.ID),
+		)
+
+		return
+	}
+
+	apiResponse, err := r.ctx.SlackClient.AppsManifestExport(
+		ctx, slack.AppsManifestExportRequest{
+			AppID: appID,
+		},
+	)
+	if err != nil {
+		r.handleSlackErrorInDiag(&response.Diagnostics, "import", "", err)
+
+		return
+	}
+
+	if apiResponse.Manifest == nil {
+		response.Diagnostics.AddError("Slack API returned empty manifest.", "apps.manifest.export returned ok but no manifest payload")
+
+		return
+	}
+
+	manifestJSON, err := json.Marshal(apiResponse.Manifest)
+	if err != nil {
+		response.Diagnostics.AddError("Failed to re-serialize the JSON manifest.", err.Error())
+
+		return
+	}
+
+	clientIDValue := types.StringNull()
+	oauthAuthorizeURL := types.StringNull()
+
+	if hasClientID {
+		clientIDValue = types.StringValue(clientID)
+		oauthAuthorizeURL = types.StringValue(apiResponse.Manifest.OauthAuthorizeURL(clientID))
+	}
+
+	// Slack only tells the secrets of an app when creating it, so they cannot be recovered here.
+	data := SlackAppModel{
+		Manifest:           customtypes.NewManifestValue(string(manifestJSON)),
+		ConflictDetection:  types.BoolNull(),
+		DeletionProtection: types.BoolValue(false),
+		ID:                 types.StringValue(appID),
+		Credentials: types.ObjectValueMust(
+			credentialsAttributeTypes,
+			map[string]attr.Value{
+				"client_id":          clientIDValue,
+				"client_secret":      types.StringNull(),
+				"verification_token": types.StringNull(),
+				"signing_secret":     types.StringNull(),
+			},
+		),
+		OauthAuthorizeURL:  oauthAuthorizeURL,
+		PermissionsUpdated: types.BoolValue(false),
+	}
+
+	response.Diagnostics.AddAttributeWarning(
+		path.Root("credentials"),
+		"The credentials of the imported Slack App are unavailable.",
+		importedCredentialsDetail(hasClientID),
+	)
+
+	name := hclgen.Identifier(apiResponse.Manifest.DisplayInformation.Name, "default")
+
+	hcl, diags := datasources.GenerateManifestHCL(ctx, name, *apiResponse.Manifest)
+	if !diags.HasError() {
+		response.Diagnostics.AddAttributeWarning(
+			path.Root("manifest"),
+			"Configuration of the imported Slack App.",
+			fmt.Sprintf(
+				"The manifest of the app can be generated by the following data source, "+
+					"by setting `manifest = data.slackapp_manifest.%s.json` on this resource:\n\n%s",
+				name,
+				hcl,
+			),
+		)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func importedCredentialsDetail(hasClientID bool) string {
+	detail := "Slack only returns the client secret, the verification token and the signing secret of an app when " +
+		"creating it, so they are null in the state. Copy them from the Basic Information page of the app if needed."
+
+	if !hasClientID {
+		detail += " Import the app as `<app_id>:<client_id>` to also set its client ID and `oauth_authorize_url`."
+	}
+
+	return detail
 }
 
-func (r *SlackApp) handleSlackErrorInDiag(diagnostics *diag.Diagnostics, err error) {
-	slackErr, ok := err.(*slack.ErrorResponse)
-	if ok && len(slackErr.Errors) > 0 {
+// setDifference returns the values of a that are not in b.
+func setDifference(a, b []string) []string {
+	var difference []string
+	for _, value := range a {
+		if !slices.Contains(b, value) {
+			difference = append(difference, value)
+		}
+	}
+
+	sort.Strings(difference)
+
+	return difference
+}
+
+// permissionChanges lists the scopes and events added to or removed from the manifest.
+func permissionChanges(before, after *manifest.App) []string {
+	var changes []string
+
+	for _, c := range []struct {
+		name          string
+		before, after []string
+	}{
+		{"bot scopes", before.BotScopes(), after.BotScopes()},
+		{"user scopes", before.UserScopes(), after.UserScopes()},
+		{"bot events", before.BotEvents(), after.BotEvents()},
+		{"user events", before.UserEvents(), after.UserEvents()},
+	} {
+		if added := setDifference(c.after, c.before); len(added) > 0 {
+			changes = append(changes, fmt.Sprintf("- added %s: %s", c.name, strings.Join(added, ", ")))
+		}
+
+		if removed := setDifference(c.before, c.after); len(removed) > 0 {
+			changes = append(changes, fmt.Sprintf("- removed %s: %s", c.name, strings.Join(removed, ", ")))
+		}
+	}
+
+	return changes
+}
+
+// warnPermissionChanges warns when the planned manifest changes the permissions of the app, which requires it to be
+// reinstalled to the workspaces.
+func warnPermissionChanges(diagnostics *diag.Diagnostics, stateManifest string, plannedManifestJSON string) {
+	before, err := manifest.Parse(stateManifest)
+	if err != nil {
+		return
+	}
+
+	after, err := manifest.Parse(plannedManifestJSON)
+	if err != nil {
+		return
+	}
+
+	changes := permissionChanges(before, after)
+	if len(changes) == 0 {
+		return
+	}
+
+	diagnostics.AddAttributeWarning(
+		path.Root("manifest"),
+		"The Slack App will need to be reinstalled.",
+		fmt.Sprintf(
+			"The planned manifest changes the permissions of the app:\n%s\n\n"+
+				"A workspace admin has to reinstall the app for the changes to take effect.",
+			strings.Join(changes, "\n"),
+		),
+	)
+}
+
+// scopesChanged reports whether the scopes requested by the app differ between the manifests.
+func scopesChanged(before, after *manifest.App) bool {
+	return len(setDifference(before.BotScopes(), after.BotScopes())) > 0 ||
+		len(setDifference(after.BotScopes(), before.BotScopes())) > 0 ||
+		len(setDifference(before.UserScopes(), after.UserScopes())) > 0 ||
+		len(setDifference(after.UserScopes(), before.UserScopes())) > 0
+}
+
+// updatedOauthAuthorizeURL returns the URL to install the app with the scopes of the planned manifest. The URL in the
+// state is kept if the scopes are unchanged, or if the client ID is unknown as for apps imported without it.
+func updatedOauthAuthorizeURL(state SlackAppModel, plannedManifestJSON string) types.String {
+	clientID, ok := state.Credentials.Attributes()["client_id"].(types.String)
+	if !ok || clientID.IsNull() || clientID.IsUnknown() {
+		return state.OauthAuthorizeURL
+	}
+
+	before, err := manifest.Parse(state.Manifest.ValueString())
+	if err != nil {
+		return state.OauthAuthorizeURL
+	}
+
+	after, err := manifest.Parse(plannedManifestJSON)
+	if err != nil {
+		return state.OauthAuthorizeURL
+	}
+
+	if !scopesChanged(before, after) {
+		return state.OauthAuthorizeURL
+	}
+
+	return types.StringValue(after.OauthAuthorizeURL(clientID.ValueString()))
+}
+
+// manifestAsJSON converts the manifest into the JSON sent to Slack, which may have been written in YAML.
+func manifestAsJSON(diagnostics *diag.Diagnostics, value customtypes.Manifest) (string, bool) {
+	manifestJSON, err := manifest.ToJSON(value.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("manifest"),
+			"Manifest must be a valid JSON or YAML.",
+			err.Error(),
+		)
+
+		return "", false
+	}
+
+	return manifestJSON, true
+}
+
+// isGeneratedManifest reports whether the manifest is exactly in the form produced by the slackapp_manifest data source.
+func isGeneratedManifest(manifestJSON string) bool {
+	var app manifest.App
+	if err := json.Unmarshal([]byte(manifestJSON), &app); err != nil {
+		return false
+	}
+
+	generated, err := app.ToJsonString()
+
+	return err == nil && generated == manifestJSON
+}
+
+// manifestErrorDetail locates the field that Slack pointed at, in terms of the data source blocks when possible.
+func manifestErrorDetail(manifestJSON string, pointer string) string {
+	if pointer == "" {
+		return "Slack did not tell which field of the manifest is invalid."
+	}
+
+	if isGeneratedManifest(manifestJSON) {
+		if blockPath, ok := slackappmanifest.PathFromPointer(pointer); ok {
+			return fmt.Sprintf(
+				"The error is at `%s` in the `slackapp_manifest` data source that generated this manifest (JSON path `%s`).",
+				blockPath,
+				pointer,
+			)
+		}
+	}
+
+	return fmt.Sprintf("The error is at JSON path `%s` of the manifest.", pointer)
+}
+
+func (r *SlackApp) handleSlackErrorInDiag(
+	diagnostics *diag.Diagnostics,
+	operation string,
+	manifestJSON string,
+	err error,
+) {
+	var slackErr *slack.ErrorResponse
+	if errors.As(err, &slackErr) && len(slackErr.Errors) > 0 {
 		for _, e := range slackErr.Errors {
-			diagnostics.AddError(e.Message, e.Pointer)
+			diagnostics.AddAttributeError(
+				path.Root("manifest"),
+				fmt.Sprintf("Failed to %s the Slack App: %s", operation, e.Message),
+				manifestErrorDetail(manifestJSON, e.Pointer),
+			)
 		}
 	} else {
-		diagnostics.AddError("Failed to create a Slack App using API.", err.Error())
+		diagnostics.AddError(fmt.Sprintf("Failed to %s the Slack App using API.", operation), common.SlackErrorDetail(err))
 	}
 }
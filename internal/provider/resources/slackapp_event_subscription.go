@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+type EventSubscriptionModel struct {
+	// Arguments
+	AppID types.String `tfsdk:"app_id"`
+	Event types.String `tfsdk:"event"`
+	Type  types.String `tfsdk:"type"`
+
+	// Attributes
+	ID types.String `tfsdk:"id"`
+}
+
+func (m *EventSubscriptionModel) parts() (string, string, string) {
+	return m.AppID.ValueString(), m.Type.ValueString(), m.Event.ValueString()
+}
+
+func (m *EventSubscriptionModel) setID(id types.String) {
+	m.ID = id
+}
+
+// subscribedEvents returns the events of the type the app subscribes to.
+func subscribedEvents(app *manifest.App, eventType string) []string {
+	if eventType == "user" {
+		return app.UserEvents()
+	}
+
+	return app.BotEvents()
+}
+
+// setSubscribedEvents replaces the events of the type the app subscribes to.
+func setSubscribedEvents(app *manifest.App, eventType string, events []string) {
+	if app.Settings == nil {
+		app.Settings = &manifest.Settings{}
+	}
+
+	if app.Settings.EventSubscriptions == nil {
+		app.Settings.EventSubscriptions = &manifest.EventSubscriptions{}
+	}
+
+	if eventType == "user" {
+		app.Settings.EventSubscriptions.UserEvents = events
+	} else {
+		app.Settings.EventSubscriptions.BotEvents = events
+	}
+}
+
+type EventSubscription struct {
+	stringSetSlice[EventSubscriptionModel, *EventSubscriptionModel]
+}
+
+func NewEventSubscription() resource.Resource {
+	return &EventSubscription{
+		stringSetSlice: stringSetSlice[EventSubscriptionModel, *EventSubscriptionModel]{
+			attribute:    "event",
+			importFormat: "<app_id>:<type>:<event>",
+			alreadyAdded: "the %s event `%s` is already subscribed to, import it instead",
+			get:          subscribedEvents,
+			set:          setSubscribedEvents,
+		},
+	}
+}
+
+func (r *EventSubscription) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	response *resource.MetadataResponse,
+) {
+	response.TypeName = "slackapp_event_subscription"
+}
+
+func (r *EventSubscription) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Represents an [event](https://api.slack.com/events) a Slack App subscribes to. The request URL or Socket Mode has to be configured on the app separately. " + appSliceDescription,
+		Attributes: map[string]schema.Attribute{
+			// Arguments
+			"app_id": &schema.StringAttribute{
+				MarkdownDescription: "ID of the app to subscribe to the event.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"event": &schema.StringAttribute{
+				MarkdownDescription: "A string matching the [event type](https://api.slack.com/events) to subscribe to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": &schema.StringAttribute{
+				MarkdownDescription: "A string containing one of `bot` or `user`, telling whether to subscribe to the event as the bot or on behalf of users. Defaults to `bot`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("bot"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("bot", "user"),
+				},
+			},
+
+			// Attributes
+			"id": &schema.StringAttribute{
+				MarkdownDescription: "Identifier of the event subscription in the form of `<app_id>:<type>:<event>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+type ScopeModel struct {
+	// Arguments
+	AppID types.String `tfsdk:"app_id"`
+	Scope types.String `tfsdk:"scope"`
+	Type  types.String `tfsdk:"type"`
+
+	// Attributes
+	ID types.String `tfsdk:"id"`
+}
+
+func (m *ScopeModel) parts() (string, string, string) {
+	return m.AppID.ValueString(), m.Type.ValueString(), m.Scope.ValueString()
+}
+
+func (m *ScopeModel) setID(id types.String) {
+	m.ID = id
+}
+
+// requestedScopes returns the scopes of the type the app requests.
+func requestedScopes(app *manifest.App, scopeType string) []string {
+	if scopeType == "user" {
+		return app.UserScopes()
+	}
+
+	return app.BotScopes()
+}
+
+// setRequestedScopes replaces the scopes of the type the app requests.
+func setRequestedScopes(app *manifest.App, scopeType string, scopes []string) {
+	if app.OauthConfig == nil {
+		app.OauthConfig = &manifest.OauthConfig{}
+	}
+
+	if app.OauthConfig.Scopes == nil {
+		app.OauthConfig.Scopes = &manifest.Scopes{}
+	}
+
+	if scopeType == "user" {
+		app.OauthConfig.Scopes.User = scopes
+	} else {
+		app.OauthConfig.Scopes.Bot = scopes
+	}
+}
+
+type Scope struct {
+	stringSetSlice[ScopeModel, *ScopeModel]
+}
+
+func NewScope() resource.Resource {
+	return &Scope{
+		stringSetSlice: stringSetSlice[ScopeModel, *ScopeModel]{
+			attribute:    "scope",
+			importFormat: "<app_id>:<type>:<scope>",
+			alreadyAdded: "the %s scope `%s` is already requested, import it instead",
+			get:          requestedScopes,
+			set:          setRequestedScopes,
+		},
+	}
+}
+
+func (r *Scope) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	response *resource.MetadataResponse,
+) {
+	response.TypeName = "slackapp_scope"
+}
+
+func (r *Scope) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Represents a [permission scope](https://api.slack.com/scopes) a Slack App requests. Changing the scopes requires the app to be reinstalled to the workspaces. " + appSliceDescription,
+		Attributes: map[string]schema.Attribute{
+			// Arguments
+			"app_id": &schema.StringAttribute{
+				MarkdownDescription: "ID of the app to request the scope for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": &schema.StringAttribute{
+				MarkdownDescription: "A string containing the [scope](https://api.slack.com/scopes) to request upon app installation.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": &schema.StringAttribute{
+				MarkdownDescription: "A string containing one of `bot` or `user`, telling whether the scope is requested for the bot token or for user tokens. Defaults to `bot`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("bot"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("bot", "user"),
+				},
+			},
+
+			// Attributes
+			"id": &schema.StringAttribute{
+				MarkdownDescription: "Identifier of the scope in the form of `<app_id>:<type>:<scope>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
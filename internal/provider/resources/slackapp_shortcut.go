@@ -0,0 +1,253 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources/slackappmanifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+type ShortcutModel struct {
+	// Arguments
+	AppID       types.String `tfsdk:"app_id"`
+	CallbackID  types.String `tfsdk:"callback_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+
+	// Attributes
+	ID types.String `tfsdk:"id"`
+}
+
+func (m *ShortcutModel) Read() manifest.Shortcut {
+	return slackappmanifest.Shortcut{
+		Name:        m.Name,
+		CallbackID:  m.CallbackID,
+		Description: m.Description,
+		Type:        m.Type,
+	}.Read()
+}
+
+func (m *ShortcutModel) set(s manifest.Shortcut) {
+	shortcut := slackappmanifest.NewShortcut(s)
+
+	m.Name = shortcut.Name
+	m.CallbackID = shortcut.CallbackID
+	m.Description = shortcut.Description
+	m.Type = shortcut.Type
+}
+
+type Shortcut struct {
+	appSlice
+}
+
+func NewShortcut() resource.Resource {
+	return &Shortcut{}
+}
+
+func (r *Shortcut) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	response *resource.MetadataResponse,
+) {
+	response.TypeName = "slackapp_shortcut"
+}
+
+func (r *Shortcut) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Represents a [shortcut](https://api.slack.com/interactivity/shortcuts) of a Slack App. " + appSliceDescription,
+		Attributes: map[string]schema.Attribute{
+			// Arguments
+			"app_id": &schema.StringAttribute{
+				MarkdownDescription: "ID of the app to add the shortcut to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"callback_id": &schema.StringAttribute{
+				MarkdownDescription: "A string containing the `callback_id` of this shortcut. Maximum length is 255 characters.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"name": &schema.StringAttribute{
+				MarkdownDescription: "A string containing the name of the shortcut.",
+				Required:            true,
+			},
+			"description": &schema.StringAttribute{
+				MarkdownDescription: "A string containing a short description of this shortcut. Maximum length is 150 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(150),
+				},
+			},
+			"type": &schema.StringAttribute{
+				MarkdownDescription: "A string containing one of `message` or `global`. This specifies which [type of shortcut](https://api.slack.com/interactivity/shortcuts) is being described.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("message", "global"),
+				},
+			},
+
+			// Attributes
+			"id": &schema.StringAttribute{
+				MarkdownDescription: "Identifier of the shortcut in the form of `<app_id>:<callback_id>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *Shortcut) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ShortcutModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	shortcut := data.Read()
+	ok := r.modify(
+		ctx, &response.Diagnostics, data.AppID.ValueString(), false, func(app *manifest.App) error {
+			if app.Features == nil {
+				app.Features = &manifest.Features{}
+			}
+
+			if slices.ContainsFunc(app.Features.Shortcuts, shortcut.Is) {
+				return fmt.Errorf("the shortcut `%s` already exists, import it instead", shortcut.CallbackID)
+			}
+
+			app.Features.Shortcuts = append(app.Features.Shortcuts, shortcut)
+
+			return nil
+		},
+	)
+	if !ok {
+		return
+	}
+
+	data.ID = types.StringValue(data.AppID.ValueString() + ":" + shortcut.CallbackID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *Shortcut) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ShortcutModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	app, ok := r.export(ctx, &response.Diagnostics, data.AppID.ValueString())
+	if !ok {
+		return
+	}
+
+	index := -1
+	if app != nil && app.Features != nil {
+		index = slices.IndexFunc(app.Features.Shortcuts, data.Read().Is)
+	}
+
+	if index < 0 {
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	data.set(app.Features.Shortcuts[index])
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *Shortcut) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data ShortcutModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	shortcut := data.Read()
+	ok := r.modify(
+		ctx, &response.Diagnostics, data.AppID.ValueString(), false, func(app *manifest.App) error {
+			index := -1
+			if app.Features != nil {
+				index = slices.IndexFunc(app.Features.Shortcuts, shortcut.Is)
+			}
+
+			if index < 0 {
+				return fmt.Errorf("the shortcut `%s` was removed outside of Terraform", shortcut.CallbackID)
+			}
+
+			// Keep the fields unknown to this provider.
+			shortcut.Unknown = app.Features.Shortcuts[index].Unknown
+			app.Features.Shortcuts[index] = shortcut
+
+			return nil
+		},
+	)
+	if !ok {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *Shortcut) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ShortcutModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	shortcut := data.Read()
+	r.modify(
+		ctx, &response.Diagnostics, data.AppID.ValueString(), true, func(app *manifest.App) error {
+			if app.Features != nil {
+				app.Features.Shortcuts = slices.DeleteFunc(app.Features.Shortcuts, shortcut.Is)
+			}
+
+			return nil
+		},
+	)
+}
+
+func (r *Shortcut) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	values, ok := splitImportID(&response.Diagnostics, request.ID, "<app_id>:<callback_id>", 1)
+	if !ok {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("app_id"), values[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("callback_id"), values[1])...)
+}
@@ -0,0 +1,266 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider/datasources/slackappmanifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+type SlashCommandModel struct {
+	// Arguments
+	AppID        types.String `tfsdk:"app_id"`
+	Command      types.String `tfsdk:"command"`
+	Description  types.String `tfsdk:"description"`
+	ShouldEscape types.Bool   `tfsdk:"should_escape"`
+	URL          types.String `tfsdk:"url"`
+	UsageHint    types.String `tfsdk:"usage_hint"`
+
+	// Attributes
+	ID types.String `tfsdk:"id"`
+}
+
+func (m *SlashCommandModel) Read() manifest.SlashCommand {
+	return slackappmanifest.SlashCommand{
+		Command:      m.Command,
+		Description:  m.Description,
+		ShouldEscape: m.ShouldEscape,
+		URL:          m.URL,
+		UsageHint:    m.UsageHint,
+	}.Read()
+}
+
+func (m *SlashCommandModel) set(c manifest.SlashCommand) {
+	command := slackappmanifest.NewSlashCommand(c)
+
+	m.Command = command.Command
+	m.Description = command.Description
+	m.URL = command.URL
+	m.UsageHint = command.UsageHint
+
+	// Slack assumes false when should_escape is omitted, so either is kept as configured.
+	if m.ShouldEscape.ValueBool() != command.ShouldEscape.ValueBool() {
+		m.ShouldEscape = command.ShouldEscape
+	}
+}
+
+type SlashCommand struct {
+	appSlice
+}
+
+func NewSlashCommand() resource.Resource {
+	return &SlashCommand{}
+}
+
+func (r *SlashCommand) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	response *resource.MetadataResponse,
+) {
+	response.TypeName = "slackapp_slash_command"
+}
+
+func (r *SlashCommand) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Represents a [slash command](https://api.slack.com/interactivity/slash-commands) of a Slack App. " + appSliceDescription,
+		Attributes: map[string]schema.Attribute{
+			// Arguments
+			"app_id": &schema.StringAttribute{
+				MarkdownDescription: "ID of the app to add the slash command to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"command": &schema.StringAttribute{
+				MarkdownDescription: "A string containing the actual slash command. Maximum length is 32 characters, and should include the leading / character.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(32),
+					stringvalidator.RegexMatches(regexp.MustCompile("^/.+$"), "must start with `/`"),
+				},
+			},
+			"description": &schema.StringAttribute{
+				MarkdownDescription: "A string containing a description of the slash command that will be displayed to users. Maximum length is 2000 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2000),
+				},
+			},
+			"should_escape": &schema.BoolAttribute{
+				MarkdownDescription: "A boolean that specifies whether or not channels, users, and links typed with the slash command should be escaped.",
+				Optional:            true,
+			},
+			"url": &schema.StringAttribute{
+				MarkdownDescription: "A string containing the full https URL that acts as the slash command's [request URL](https://api.slack.com/interactivity/slash-commands#creating_commands).",
+				Optional:            true,
+			},
+			"usage_hint": &schema.StringAttribute{
+				MarkdownDescription: "A string a short usage hint about the slash command for users. Maximum length is 1000 characters.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1000),
+				},
+			},
+
+			// Attributes
+			"id": &schema.StringAttribute{
+				MarkdownDescription: "Identifier of the slash command in the form of `<app_id>:<command>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SlashCommand) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data SlashCommandModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	command := data.Read()
+	ok := r.modify(
+		ctx, &response.Diagnostics, data.AppID.ValueString(), false, func(app *manifest.App) error {
+			if app.Features == nil {
+				app.Features = &manifest.Features{}
+			}
+
+			if slices.ContainsFunc(app.Features.SlashCommands, command.Is) {
+				return fmt.Errorf("the slash command `%s` already exists, import it instead", command.Command)
+			}
+
+			app.Features.SlashCommands = append(app.Features.SlashCommands, command)
+
+			return nil
+		},
+	)
+	if !ok {
+		return
+	}
+
+	data.ID = types.StringValue(data.AppID.ValueString() + ":" + command.Command)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *SlashCommand) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data SlashCommandModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	app, ok := r.export(ctx, &response.Diagnostics, data.AppID.ValueString())
+	if !ok {
+		return
+	}
+
+	index := -1
+	if app != nil && app.Features != nil {
+		index = slices.IndexFunc(app.Features.SlashCommands, data.Read().Is)
+	}
+
+	if index < 0 {
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	data.set(app.Features.SlashCommands[index])
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *SlashCommand) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data SlashCommandModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	command := data.Read()
+	ok := r.modify(
+		ctx, &response.Diagnostics, data.AppID.ValueString(), false, func(app *manifest.App) error {
+			index := -1
+			if app.Features != nil {
+				index = slices.IndexFunc(app.Features.SlashCommands, command.Is)
+			}
+
+			if index < 0 {
+				return fmt.Errorf("the slash command `%s` was removed outside of Terraform", command.Command)
+			}
+
+			// Keep the fields unknown to this provider.
+			command.Unknown = app.Features.SlashCommands[index].Unknown
+			app.Features.SlashCommands[index] = command
+
+			return nil
+		},
+	)
+	if !ok {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *SlashCommand) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data SlashCommandModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	command := data.Read()
+	r.modify(
+		ctx, &response.Diagnostics, data.AppID.ValueString(), true, func(app *manifest.App) error {
+			if app.Features != nil {
+				app.Features.SlashCommands = slices.DeleteFunc(app.Features.SlashCommands, command.Is)
+			}
+
+			return nil
+		},
+	)
+}
+
+func (r *SlashCommand) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	values, ok := splitImportID(&response.Diagnostics, request.ID, "<app_id>:<command>", 1)
+	if !ok {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("app_id"), values[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("command"), values[1])...)
+}
@@ -0,0 +1,73 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/slacktest"
+)
+
+const applicationDataSourceAddress = "data.slackapp_application.test"
+
+func applicationDataSourceConfig(server *slacktest.Server, appID string) string {
+	return providerConfig(
+		server, fmt.Sprintf(
+			`
+data "slackapp_application" "test" {
+  app_id = %q
+}
+`, appID,
+		),
+	)
+}
+
+func TestAccApplicationDataSource(t *testing.T) {
+	server := newSlackServer(t)
+
+	appID, err := server.CreateApp(
+		`{"display_information":{"name":"existing"},"features":{"slash_commands":[{"command":"/hello","description":"Says hello."}]},"oauth_config":{"scopes":{"bot":["commands","chat:write"]}}}`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: applicationDataSourceConfig(server, appID),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr(applicationDataSourceAddress, "app_id", appID),
+						resource.TestCheckResourceAttr(applicationDataSourceAddress, "display_information.name", "existing"),
+						resource.TestCheckResourceAttr(applicationDataSourceAddress, "features.slash_command.#", "1"),
+						resource.TestCheckResourceAttr(applicationDataSourceAddress, "features.slash_command.0.command", "/hello"),
+						resource.TestCheckTypeSetElemAttr(applicationDataSourceAddress, "oauth_config.scopes.bot.*", "chat:write"),
+						resource.TestCheckTypeSetElemAttr(applicationDataSourceAddress, "oauth_config.scopes.bot.*", "commands"),
+						resource.TestMatchResourceAttr(applicationDataSourceAddress, "json", regexp.MustCompile(`"name":"existing"`)),
+						resource.TestMatchResourceAttr(applicationDataSourceAddress, "yaml", regexp.MustCompile(`name: existing`)),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestAccApplicationDataSourceAppNotFound(t *testing.T) {
+	server := newSlackServer(t)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      applicationDataSourceConfig(server, "A0MISSING"),
+					ExpectError: regexp.MustCompile(`Failed to read the Slack App using API`),
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,484 @@
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/slacktest"
+)
+
+const applicationAddress = "slackapp_application.test"
+
+func applicationConfig(server *slacktest.Server, manifestJSON string) string {
+	return providerConfig(
+		server, fmt.Sprintf(
+			`
+resource "slackapp_application" "test" {
+  manifest = %q
+}
+`, manifestJSON,
+		),
+	)
+}
+
+// checkSameApp records the app ID on the first call, and checks that later calls see the same app.
+func checkSameApp(appID *string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		id, err := attribute(s, applicationAddress, "id")
+		if err != nil {
+			return err
+		}
+
+		if *appID == "" {
+			*appID = id
+		} else if id != *appID {
+			return fmt.Errorf("the app %s was replaced by %s", *appID, id)
+		}
+
+		return nil
+	}
+}
+
+func TestAccApplicationLifecycle(t *testing.T) {
+	server := newSlackServer(t)
+
+	// Slack exports the manifest in another key order, which must not show up as a diff.
+	created := `{"oauth_config":{"scopes":{"bot":["commands","chat:write"]}},"display_information":{"name":"created"}}`
+	updated := `{"display_information":{"name":"updated"},"oauth_config":{"scopes":{"bot":["chat:write","commands","users:read"]}}}`
+
+	var appID string
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			CheckDestroy:             checkNoApps(server),
+			Steps: []resource.TestStep{
+				{
+					Config: applicationConfig(server, created),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						checkSameApp(&appID),
+						checkManifest(server, applicationAddress, created),
+						resource.TestCheckResourceAttr(applicationAddress, "manifest", created),
+						resource.TestCheckResourceAttr(applicationAddress, "deletion_protection", "false"),
+						resource.TestCheckResourceAttr(applicationAddress, "permissions_updated", "false"),
+						resource.TestCheckResourceAttrSet(applicationAddress, "credentials.client_id"),
+						resource.TestCheckResourceAttrSet(applicationAddress, "credentials.client_secret"),
+						resource.TestCheckResourceAttrSet(applicationAddress, "oauth_authorize_url"),
+					),
+				},
+				{
+					Config:   applicationConfig(server, created),
+					PlanOnly: true,
+				},
+				{
+					Config: applicationConfig(server, updated),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(applicationAddress, plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						checkSameApp(&appID),
+						checkManifest(server, applicationAddress, updated),
+						resource.TestCheckResourceAttr(applicationAddress, "manifest", updated),
+						resource.TestCheckResourceAttr(applicationAddress, "permissions_updated", "true"),
+						resource.TestMatchResourceAttr(
+							applicationAddress,
+							"oauth_authorize_url",
+							regexp.MustCompile(`scope=chat:write,commands,users:read&`),
+						),
+					),
+				},
+				{
+					ResourceName: applicationAddress,
+					ImportState:  true,
+					ImportStateIdFunc: func(s *terraform.State) (string, error) {
+						clientID, err := attribute(s, applicationAddress, "credentials.client_id")
+						if err != nil {
+							return "", err
+						}
+
+						return appID + ":" + clientID, nil
+					},
+					ImportStateVerify: true,
+					// Slack does not tell the secrets after creating the app, and the import has never updated it.
+					ImportStateVerifyIgnore: []string{
+						"credentials.client_secret",
+						"credentials.signing_secret",
+						"credentials.verification_token",
+						"permissions_updated",
+					},
+				},
+			},
+		},
+	)
+}
+
+func TestAccApplicationYAMLManifest(t *testing.T) {
+	server := newSlackServer(t)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			CheckDestroy:             checkNoApps(server),
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(
+						server, `
+resource "slackapp_application" "test" {
+  manifest = <<-EOT
+    display_information:
+      name: yaml
+    settings:
+      socket_mode_enabled: false
+  EOT
+}
+`,
+					),
+					Check: checkManifest(server, applicationAddress, `{"display_information":{"name":"yaml"}}`),
+				},
+			},
+		},
+	)
+}
+
+func TestAccApplicationRemovedOutsideOfTerraform(t *testing.T) {
+	server := newSlackServer(t)
+
+	config := applicationConfig(server, `{"display_information":{"name":"removed"}}`)
+
+	var appID string
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			CheckDestroy:             checkNoApps(server),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check:  checkSameApp(&appID),
+				},
+				{
+					PreConfig: func() {
+						server.DeleteApp(appID)
+					},
+					Config: config,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(applicationAddress, plancheck.ResourceActionCreate),
+						},
+					},
+					Check: checkManifest(server, applicationAddress, `{"display_information":{"name":"removed"}}`),
+				},
+			},
+		},
+	)
+}
+
+func TestAccApplicationInvalidManifest(t *testing.T) {
+	server := newSlackServer(t)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					PreConfig: func() {
+						server.Inject(
+							"apps.manifest.validate",
+							slacktest.InvalidManifest(
+								slacktest.ErrorDetail{Message: "must be shorter", Pointer: "/display_information/name"},
+							),
+						)
+					},
+					Config:      applicationConfig(server, `{"display_information":{"name":"invalid"}}`),
+					ExpectError: regexp.MustCompile(`(?s)must be shorter.*/display_information/name`),
+				},
+			},
+		},
+	)
+}
+
+func TestAccApplicationInvalidGeneratedManifest(t *testing.T) {
+	server := newSlackServer(t)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					PreConfig: func() {
+						server.Inject(
+							"apps.manifest.validate",
+							slacktest.InvalidManifest(
+								slacktest.ErrorDetail{Message: "must be a URL", Pointer: "/features/slash_commands/1/url"},
+							),
+						)
+					},
+					Config: providerConfig(
+						server, `
+data "slackapp_manifest" "test" {
+  display_information {
+    name = "generated"
+  }
+
+  features {
+    slash_command {
+      command     = "/first"
+      description = "The first command."
+    }
+
+    slash_command {
+      command     = "/second"
+      description = "The second command."
+      url         = "invalid"
+    }
+  }
+}
+
+resource "slackapp_application" "test" {
+  manifest = data.slackapp_manifest.test.json
+}
+`,
+					),
+					// The error points at the block of the data source rather than the generated JSON.
+					ExpectError: regexp.MustCompile(`(?s)must be a URL.*features\.slash_command\[1\]\.url`),
+				},
+			},
+		},
+	)
+}
+
+func protectedApplicationConfig(server *slacktest.Server, deletionProtection bool) string {
+	return providerConfig(
+		server, fmt.Sprintf(
+			`
+resource "slackapp_application" "test" {
+  manifest            = %q
+  deletion_protection = %t
+  conflict_detection  = true
+}
+`, `{"display_information":{"name":"protected"}}`, deletionProtection,
+		),
+	)
+}
+
+func TestAccApplicationDeletionProtection(t *testing.T) {
+	server := newSlackServer(t)
+
+	var appID string
+
+	var updates int
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			CheckDestroy:             checkNoApps(server),
+			Steps: []resource.TestStep{
+				{
+					Config: protectedApplicationConfig(server, true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						checkSameApp(&appID),
+						resource.TestCheckResourceAttr(applicationAddress, "deletion_protection", "true"),
+					),
+				},
+				{
+					Config:      protectedApplicationConfig(server, true),
+					Destroy:     true,
+					ExpectError: regexp.MustCompile(`The Slack App is protected from deletion`),
+				},
+				{
+					PreConfig: func() {
+						updates = server.Calls("apps.manifest.update")
+					},
+					Config: protectedApplicationConfig(server, false),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction(applicationAddress, plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						checkSameApp(&appID),
+						resource.TestCheckResourceAttr(applicationAddress, "deletion_protection", "false"),
+						resource.TestCheckResourceAttrSet(applicationAddress, "credentials.client_secret"),
+						resource.TestCheckResourceAttrSet(applicationAddress, "oauth_authorize_url"),
+						func(*terraform.State) error {
+							// Changing only the arguments of the provider must not update the app in Slack.
+							if got := server.Calls("apps.manifest.update"); got != updates {
+								return fmt.Errorf("apps.manifest.update was called %d times, want %d", got, updates)
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+		},
+	)
+}
+
+func backedUpApplicationConfig(server *slacktest.Server, directory string) string {
+	return fmt.Sprintf(
+		`
+provider "slackapp" {
+  base_url                = %q
+  app_configuration_token = %q
+
+  backup_on_destroy = {
+    directory = %q
+  }
+}
+
+resource "slackapp_application" "test" {
+  manifest = %q
+}
+`, server.BaseURL(), testToken, directory, `{"display_information":{"name":"backed up"}}`,
+	)
+}
+
+func TestAccApplicationBackupOnDestroy(t *testing.T) {
+	server := newSlackServer(t)
+
+	// A directory cannot be created under a regular file, even by root.
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	directory := filepath.Join(t.TempDir(), "backups")
+
+	var appID string
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+				checkNoApps(server),
+				func(*terraform.State) error {
+					entries, err := os.ReadDir(directory)
+					if err != nil {
+						return err
+					}
+
+					if len(entries) != 1 || !strings.HasPrefix(entries[0].Name(), appID+"-") {
+						return fmt.Errorf("the backups are %v, want one of the app %s", entries, appID)
+					}
+
+					return nil
+				},
+			),
+			Steps: []resource.TestStep{
+				{
+					Config: backedUpApplicationConfig(server, filepath.Join(file, "backups")),
+					Check:  checkSameApp(&appID),
+				},
+				{
+					// The app must be kept when its manifest cannot be backed up.
+					Config:      backedUpApplicationConfig(server, filepath.Join(file, "backups")),
+					Destroy:     true,
+					ExpectError: regexp.MustCompile(`Failed to back up the Slack App`),
+				},
+				{
+					Config: backedUpApplicationConfig(server, directory),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						checkSameApp(&appID),
+						checkManifest(server, applicationAddress, `{"display_information":{"name":"backed up"}}`),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestAccApplicationImport(t *testing.T) {
+	server := newSlackServer(t)
+
+	manifestJSON := `{"display_information":{"name":"imported"},"oauth_config":{"scopes":{"bot":["chat:write"]}}}`
+
+	appID, err := server.CreateApp(manifestJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := applicationConfig(server, manifestJSON)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:        config,
+					ResourceName:  applicationAddress,
+					ImportState:   true,
+					ImportStateId: appID,
+					ImportStateCheck: func(states []*terraform.InstanceState) error {
+						if len(states) != 1 {
+							return fmt.Errorf("%d resources were imported, want 1", len(states))
+						}
+
+						attributes := states[0].Attributes
+						if attributes["id"] != appID {
+							return fmt.Errorf("the app %s was imported, want %s", attributes["id"], appID)
+						}
+
+						if equal, err := manifest.SemanticallyEqual(attributes["manifest"], manifestJSON); err != nil || !equal {
+							return fmt.Errorf("the manifest %s was imported, want %s", attributes["manifest"], manifestJSON)
+						}
+
+						// Without the client ID, the URL to install the app cannot be built.
+						for _, name := range []string{"credentials.client_id", "oauth_authorize_url"} {
+							if value, ok := attributes[name]; ok && value != "" {
+								return fmt.Errorf("%s was imported as %s", name, value)
+							}
+						}
+
+						return nil
+					},
+				},
+				{
+					Config:        config,
+					ResourceName:  applicationAddress,
+					ImportState:   true,
+					ImportStateId: appID + ":1234.5678",
+					ImportStateCheck: func(states []*terraform.InstanceState) error {
+						attributes := states[0].Attributes
+
+						if got := attributes["credentials.client_id"]; got != "1234.5678" {
+							return fmt.Errorf("the client ID %s was imported, want 1234.5678", got)
+						}
+
+						want := "https://slack.com/oauth/v2/authorize?client_id=1234.5678&scope=chat:write&user_scope="
+						if got := attributes["oauth_authorize_url"]; got != want {
+							return fmt.Errorf("oauth_authorize_url was imported as %s, want %s", got, want)
+						}
+
+						return nil
+					},
+				},
+				{
+					Config:        config,
+					ResourceName:  applicationAddress,
+					ImportState:   true,
+					ImportStateId: appID + ":",
+					ExpectError:   regexp.MustCompile("Invalid import ID"),
+				},
+				{
+					Config:        config,
+					ResourceName:  applicationAddress,
+					ImportState:   true,
+					ImportStateId: "A0MISSING",
+					ExpectError:   regexp.MustCompile("Failed to import the Slack App"),
+				},
+			},
+		},
+	)
+}
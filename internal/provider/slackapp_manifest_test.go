@@ -0,0 +1,169 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const manifestAddress = "data.slackapp_manifest.test"
+
+func TestAccManifestFunctionWithoutParameters(t *testing.T) {
+	server := newSlackServer(t)
+
+	resource.Test(
+		t, resource.TestCase{
+			ProtoV6ProviderFactories: protoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig(
+						server, `
+data "slackapp_manifest" "test" {
+  display_information {
+    name = "functions"
+  }
+
+  function {
+    callback_id = "noop"
+    title       = "Noop"
+  }
+}
+`,
+					),
+					// Slack requires both parameter sets on every function, even without parameters.
+					Check: resource.TestCheckResourceAttr(
+						manifestAddress,
+						"json",
+						`{"display_information":{"name":"functions"},"functions":{"noop":{"title":"Noop","input_parameters":{"properties":{}},"output_parameters":{"properties":{}}}}}`,
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestAccManifestBlocks(t *testing.T) {
+	server := newSlackServer(t)
+
+	tests := []struct {
+		name   string
+		config string
+		want   string
+	}{
+		{
+			name: "workflow",
+			config: `
+  workflow {
+    callback_id = "greet"
+    title       = "Greet"
+    description = "Greets a user."
+
+    input_parameter {
+      name     = "user"
+      type     = "slack#/types/user_id"
+      required = true
+    }
+
+    step {
+      id          = "0"
+      function_id = "slack#/functions/send_message"
+      inputs = jsonencode({
+        channel_id = "C0123"
+        message    = "Hello, {{inputs.user}}!"
+      })
+    }
+
+    step {
+      id          = "1"
+      function_id = "#/functions/log"
+    }
+  }
+`,
+			want: `"workflows":{"greet":{"title":"Greet","description":"Greets a user.",` +
+				`"input_parameters":{"properties":{"user":{"type":"slack#/types/user_id"}},"required":["user"]},` +
+				`"steps":[{"id":"0","function_id":"slack#/functions/send_message",` +
+				`"inputs":{"channel_id":"C0123","message":"Hello, {{inputs.user}}!"}},` +
+				`{"id":"1","function_id":"#/functions/log"}]}}`,
+		},
+		{
+			name: "datastore",
+			config: `
+  datastore {
+    name        = "tasks"
+    primary_key = "id"
+
+    attribute {
+      name = "id"
+      type = "string"
+    }
+
+    attribute {
+      name = "done"
+      type = "boolean"
+    }
+  }
+`,
+			want: `"datastores":{"tasks":{"primary_key":"id","attributes":{"done":{"type":"boolean"},"id":{"type":"string"}}}}`,
+		},
+		{
+			name: "custom type",
+			config: `
+  type {
+    name  = "task"
+    type  = "object"
+    title = "Task"
+
+    property {
+      name     = "title"
+      type     = "string"
+      required = true
+    }
+
+    property {
+      name = "assignee"
+      type = "slack#/types/user_id"
+    }
+  }
+`,
+			want: `"types":{"task":{"type":"object","title":"Task",` +
+				`"properties":{"assignee":{"type":"slack#/types/user_id"},"title":{"type":"string"}},"required":["title"]}}`,
+		},
+		{
+			name: "outgoing domains",
+			config: `
+  outgoing_domains = ["api.example.com", "example.org"]
+`,
+			want: `"outgoing_domains":["api.example.com","example.org"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				resource.Test(
+					t, resource.TestCase{
+						ProtoV6ProviderFactories: protoV6ProviderFactories,
+						Steps: []resource.TestStep{
+							{
+								Config: providerConfig(
+									server, `
+data "slackapp_manifest" "test" {
+  display_information {
+    name = "blocks"
+  }
+`+tt.config+`}
+`,
+								),
+								Check: resource.TestCheckResourceAttr(
+									manifestAddress,
+									"json",
+									`{"display_information":{"name":"blocks"},`+tt.want+`}`,
+								),
+							},
+						},
+					},
+				)
+			},
+		)
+	}
+}
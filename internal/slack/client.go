@@ -4,21 +4,38 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const defaultBaseURL = "https://slack.com/api/"
+var errNoRefreshToken = errors.New("no refresh token is available")
+
+const (
+	defaultBaseURL = "https://slack.com/api/"
+
+	// tokenRefreshMargin is how long before its expiry an app configuration token gets rotated.
+	tokenRefreshMargin = 5 * time.Minute
+)
 
 type Client struct {
-	baseURL               string
-	appConfigurationToken *string
-	refreshToken          *string
-	httpClient            *http.Client
+	baseURL                        string
+	appConfigurationToken          *string
+	appConfigurationTokenExpiresAt *time.Time
+	refreshToken                   *string
+	tokenMutex                     sync.Mutex
+	tokenRotation                  *tokenRotation
+	httpClient                     *http.Client
+	maxRetries                     int
+	maxBackoff                     time.Duration
+	tokenStore                     TokenStore
+	tokenStoreLoaded               bool
 }
 
 func NewClient(appConfigurationToken string) *Client {
@@ -26,6 +43,8 @@ func NewClient(appConfigurationToken string) *Client {
 		baseURL:               defaultBaseURL,
 		appConfigurationToken: &appConfigurationToken,
 		httpClient:            http.DefaultClient,
+		maxRetries:            defaultMaxRetries,
+		maxBackoff:            defaultMaxBackoff,
 	}
 }
 
@@ -34,6 +53,18 @@ func NewClientFromRefreshToken(refreshToken string) *Client {
 		baseURL:      defaultBaseURL,
 		refreshToken: &refreshToken,
 		httpClient:   http.DefaultClient,
+		maxRetries:   defaultMaxRetries,
+		maxBackoff:   defaultMaxBackoff,
+	}
+}
+
+func NewClientFromTokenStore(tokenStore TokenStore) *Client {
+	return &Client{
+		baseURL:    defaultBaseURL,
+		httpClient: http.DefaultClient,
+		maxRetries: defaultMaxRetries,
+		maxBackoff: defaultMaxBackoff,
+		tokenStore: tokenStore,
 	}
 }
 
@@ -63,8 +94,8 @@ func (c *Client) createRequest(
 		return nil, err
 	}
 
-	if c.appConfigurationToken != nil {
-		httpRequest.Header.Set("Authorization", "Bearer "+*c.appConfigurationToken)
+	if token := c.currentAppConfigurationToken(); token != nil {
+		httpRequest.Header.Set("Authorization", "Bearer "+*token)
 	}
 
 	httpRequest.Header.Set("User-Agent", "yumemi-inc/terraform-provider-slackapp")
@@ -109,36 +140,247 @@ func (c *Client) createFormRequest(
 	return httpRequest, nil
 }
 
-func (c *Client) refreshAppConfigurationToken(ctx context.Context) error {
-	if c.refreshToken == nil {
+// tokenRotation is a rotation in flight, which concurrent operations wait for instead of starting their own.
+type tokenRotation struct {
+	done chan struct{}
+	err  error
+}
+
+func (c *Client) currentAppConfigurationToken() *string {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	return c.appConfigurationToken
+}
+
+func (c *Client) refreshAppConfigurationToken(ctx context.Context, staleToken *string) error {
+	c.tokenMutex.Lock()
+
+	if rotation := c.tokenRotation; rotation != nil {
+		c.tokenMutex.Unlock()
+
+		select {
+		case <-rotation.done:
+			return rotation.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// Another operation has finished rotating since the caller looked at the token.
+	if c.appConfigurationToken != staleToken || c.refreshToken == nil {
+		c.tokenMutex.Unlock()
+
 		return nil
 	}
 
-	response, err := c.ToolingTokensRotate(ctx, *c.refreshToken)
+	rotation := &tokenRotation{
+		done: make(chan struct{}),
+	}
+	c.tokenRotation = rotation
+	refreshToken := *c.refreshToken
+
+	c.tokenMutex.Unlock()
+
+	// Slack invalidates the refresh token as soon as it accepts the request, so the rotation must not be abandoned
+	// halfway when the operation that started it is cancelled.
+	rotation.err = c.rotateAppConfigurationToken(context.WithoutCancel(ctx), refreshToken)
+
+	c.tokenMutex.Lock()
+	c.tokenRotation = nil
+	c.tokenMutex.Unlock()
+
+	close(rotation.done)
+
+	return rotation.err
+}
+
+// rotateAppConfigurationToken rotates the refresh token and saves the new token pair into the store. The store is
+// checked right before rotating, as another process sharing it may have rotated the refresh token in the meantime.
+func (c *Client) rotateAppConfigurationToken(ctx context.Context, refreshToken string) error {
+	if store, ok := c.tokenStore.(LockingTokenStore); ok {
+		err := store.Update(
+			ctx, func(stored *Token) (*Token, error) {
+				return c.rotateUnlessStored(ctx, refreshToken, stored)
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to rotate the stored token: %w", err)
+		}
+
+		return nil
+	}
+
+	var stored *Token
+	if c.tokenStore != nil {
+		var err error
+		if stored, err = c.tokenStore.Load(ctx); err != nil {
+			return fmt.Errorf("failed to load the stored token: %w", err)
+		}
+	}
+
+	token, err := c.rotateUnlessStored(ctx, refreshToken, stored)
 	if err != nil {
 		return err
 	}
 
+	if c.tokenStore != nil && token != nil {
+		if err := c.tokenStore.Save(ctx, *token); err != nil {
+			return fmt.Errorf("failed to save the rotated token: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// rotateUnlessStored rotates the refresh token, unless another process has already rotated it and stored a token
+// that is still valid, which is used instead. It returns the token to store, or nil if the stored one is used.
+func (c *Client) rotateUnlessStored(ctx context.Context, refreshToken string, stored *Token) (*Token, error) {
+	if stored != nil && stored.RefreshToken != refreshToken {
+		tflog.Debug(ctx, "The token has been rotated by another process, resuming from the stored token.")
+
+		if time.Until(stored.ExpiresAt) >= tokenRefreshMargin {
+			c.setToken(*stored)
+
+			return nil, nil
+		}
+
+		refreshToken = stored.RefreshToken
+	}
+
+	response, err := c.ToolingTokensRotate(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("%+v", response))
 
-	c.appConfigurationToken = &response.Token
-	c.refreshToken = &response.RefreshToken
+	token := Token{
+		AppConfigurationToken: response.Token,
+		RefreshToken:          response.RefreshToken,
+		ExpiresAt:             *response.ExpiresAt.Time(),
+	}
+
+	c.setToken(token)
+
+	return &token, nil
+}
+
+func (c *Client) setToken(token Token) {
+	c.tokenMutex.Lock()
+	c.appConfigurationToken = &token.AppConfigurationToken
+	c.appConfigurationTokenExpiresAt = &token.ExpiresAt
+	c.refreshToken = &token.RefreshToken
+	c.tokenMutex.Unlock()
+}
+
+// loadStoredToken replaces the configured tokens with the stored ones, which are always newer.
+// It must be called with tokenMutex held.
+func (c *Client) loadStoredToken(ctx context.Context) error {
+	if c.tokenStore == nil || c.tokenStoreLoaded {
+		return nil
+	}
+
+	token, err := c.tokenStore.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load the stored token: %w", err)
+	}
+
+	c.tokenStoreLoaded = true
+
+	if token == nil {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Resuming from the stored token.")
+
+	c.refreshToken = &token.RefreshToken
+	c.appConfigurationToken = nil
+	c.appConfigurationTokenExpiresAt = nil
+
+	if time.Now().Before(token.ExpiresAt) {
+		c.appConfigurationToken = &token.AppConfigurationToken
+		c.appConfigurationTokenExpiresAt = &token.ExpiresAt
+	}
 
 	return nil
 }
 
+func (c *Client) appConfigurationTokenExpiresSoon() bool {
+	return c.appConfigurationTokenExpiresAt != nil &&
+		time.Until(*c.appConfigurationTokenExpiresAt) < tokenRefreshMargin
+}
+
 func (c *Client) ensureAppConfigurationToken(ctx context.Context) error {
-	if c.appConfigurationToken == nil {
+	c.tokenMutex.Lock()
+
+	if err := c.loadStoredToken(ctx); err != nil {
+		c.tokenMutex.Unlock()
+
+		return err
+	}
+
+	token := c.appConfigurationToken
+	canRefresh := c.refreshToken != nil
+	expiresSoon := c.appConfigurationTokenExpiresSoon()
+
+	c.tokenMutex.Unlock()
+
+	if token == nil && !canRefresh {
+		return errors.New("no app configuration token or refresh token is available")
+	}
+
+	if token == nil {
 		tflog.Debug(ctx, "No app configuration token is available, refreshing token.")
 
-		if err := c.refreshAppConfigurationToken(ctx); err != nil {
+		if err := c.refreshAppConfigurationToken(ctx, token); err != nil {
 			return err
 		}
 
 		return nil
 	}
 
+	if canRefresh && expiresSoon {
+		tflog.Debug(ctx, "App configuration token is about to expire, refreshing token.")
+
+		return c.refreshAppConfigurationToken(ctx, token)
+	}
+
 	tflog.Debug(ctx, "App configuration token is already provided, continuing.")
 
 	return nil
 }
+
+// renewAppConfigurationToken rotates the app configuration token that Slack rejected, and returns the new one.
+// If another operation has rotated it in the meantime, its result is reused instead of rotating again.
+func (c *Client) renewAppConfigurationToken(ctx context.Context, rejectedToken string) (string, error) {
+	c.tokenMutex.Lock()
+
+	if c.refreshToken == nil {
+		c.tokenMutex.Unlock()
+
+		return "", errNoRefreshToken
+	}
+
+	staleToken := c.appConfigurationToken
+	if staleToken != nil && *staleToken != rejectedToken {
+		c.tokenMutex.Unlock()
+
+		return *staleToken, nil
+	}
+
+	c.tokenMutex.Unlock()
+
+	tflog.Debug(ctx, "App configuration token was rejected, refreshing token.")
+
+	if err := c.refreshAppConfigurationToken(ctx, staleToken); err != nil {
+		return "", err
+	}
+
+	token := c.currentAppConfigurationToken()
+	if token == nil {
+		return "", errors.New("no app configuration token is available after refreshing")
+	}
+
+	return *token, nil
+}
@@ -0,0 +1,113 @@
+package slack_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/slacktest"
+)
+
+const parallelCalls = 16
+
+func exportInParallel(t *testing.T, client *slack.Client, appID string) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+	for i := 0; i < parallelCalls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := client.AppsManifestExport(context.Background(), slack.AppsManifestExportRequest{AppID: appID})
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// Run with `go test -race` to also catch data races on the tokens shared by the calls.
+func TestClientRotatesTokenOnceForParallelCalls(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+
+	server.AddRefreshToken("xoxe-1")
+
+	appID, err := server.CreateApp(`{"display_information":{"name":"parallel"}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := slack.NewClientFromRefreshToken("xoxe-1").WithBaseURL(server.BaseURL())
+
+	exportInParallel(t, client, appID)
+
+	if calls := server.Calls("tooling.tokens.rotate"); calls != 1 {
+		t.Fatalf("tooling.tokens.rotate was called %d times, want 1", calls)
+	}
+
+	// Every call is rejected with the expired token, and only one of them may rotate it again.
+	server.ExpireAllTokens()
+
+	exportInParallel(t, client, appID)
+
+	if calls := server.Calls("tooling.tokens.rotate"); calls != 2 {
+		t.Fatalf("tooling.tokens.rotate was called %d times after the tokens expired, want 2", calls)
+	}
+}
+
+func TestClientRotatesTokenBeforeItExpires(t *testing.T) {
+	tests := []struct {
+		name          string
+		tokenLifetime time.Duration
+		wantRotations int
+	}{
+		{
+			name:          "expiring within the margin",
+			tokenLifetime: 3 * time.Minute,
+			wantRotations: 2,
+		},
+		{
+			name:          "expiring later",
+			tokenLifetime: 10 * time.Minute,
+			wantRotations: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				server := slacktest.NewServer()
+				defer server.Close()
+
+				server.TokenLifetime = tt.tokenLifetime
+				server.AddRefreshToken("xoxe-1")
+
+				appID, err := server.CreateApp(`{"display_information":{"name":"expiring"}}`)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				client := slack.NewClientFromRefreshToken("xoxe-1").WithBaseURL(server.BaseURL())
+
+				// The first call rotates the refresh token to obtain a token, which is still accepted by Slack at the
+				// second call.
+				for i := 0; i < 2; i++ {
+					_, err := client.AppsManifestExport(context.Background(), slack.AppsManifestExportRequest{AppID: appID})
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				if calls := server.Calls("tooling.tokens.rotate"); calls != tt.wantRotations {
+					t.Errorf("tooling.tokens.rotate was called %d times, want %d", calls, tt.wantRotations)
+				}
+			},
+		)
+	}
+}
@@ -1,12 +1,41 @@
 package slack
 
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidAuth     = errors.New("invalid auth")
+	ErrTokenExpired    = errors.New("token expired")
+	ErrAppNotFound     = errors.New("app not found")
+	ErrInvalidManifest = errors.New("invalid manifest")
+	ErrRateLimited     = errors.New("rate limited")
+	ErrMissingScope    = errors.New("missing scope")
+)
+
+// errorCodes maps the sentinel errors to the Slack error codes they stand for.
+var errorCodes = map[error][]string{
+	ErrInvalidAuth:     {"invalid_auth", "not_authed", "account_inactive", "token_revoked", "invalid_refresh_token"},
+	ErrTokenExpired:    {"token_expired"},
+	ErrAppNotFound:     {"app_not_found", "invalid_app_id"},
+	ErrInvalidManifest: {"invalid_manifest"},
+	ErrRateLimited:     {"ratelimited", "rate_limited"},
+	ErrMissingScope:    {"missing_scope", "no_permission"},
+}
+
 type ErrorResponse struct {
-	Ok     bool   `json:"ok"`
-	Error_ string `json:"error"`
-	Errors []struct {
+	Ok       bool   `json:"ok"`
+	Error_   string `json:"error"`
+	Needed   string `json:"needed,omitempty"`
+	Provided string `json:"provided,omitempty"`
+	Errors   []struct {
 		Message string `json:"message"`
 		Pointer string `json:"pointer"`
 	} `json:"errors"`
+
+	// HTTPStatus is the status code of the HTTP response that carried the error.
+	HTTPStatus int `json:"-"`
 }
 
 func (e *ErrorResponse) IsOk() bool {
@@ -14,5 +43,20 @@ func (e *ErrorResponse) IsOk() bool {
 }
 
 func (e *ErrorResponse) Error() string {
+	if e.Needed != "" {
+		return fmt.Sprintf("%s (needed: %s, provided: %s)", e.Error_, e.Needed, e.Provided)
+	}
+
 	return e.Error_
 }
+
+// Is maps Slack error codes to the sentinel errors, so that callers can use errors.Is.
+func (e *ErrorResponse) Is(target error) bool {
+	for _, code := range errorCodes[target] {
+		if e.Error_ == code {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package slack_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/slacktest"
+)
+
+func TestErrorResponseIs(t *testing.T) {
+	sentinels := []error{
+		slack.ErrInvalidAuth,
+		slack.ErrTokenExpired,
+		slack.ErrAppNotFound,
+		slack.ErrInvalidManifest,
+		slack.ErrRateLimited,
+		slack.ErrMissingScope,
+	}
+
+	tests := []struct {
+		code string
+		want error
+	}{
+		{code: "invalid_auth", want: slack.ErrInvalidAuth},
+		{code: "not_authed", want: slack.ErrInvalidAuth},
+		{code: "token_revoked", want: slack.ErrInvalidAuth},
+		{code: "invalid_refresh_token", want: slack.ErrInvalidAuth},
+		{code: "token_expired", want: slack.ErrTokenExpired},
+		{code: "app_not_found", want: slack.ErrAppNotFound},
+		{code: "invalid_app_id", want: slack.ErrAppNotFound},
+		{code: "invalid_manifest", want: slack.ErrInvalidManifest},
+		{code: "ratelimited", want: slack.ErrRateLimited},
+		{code: "rate_limited", want: slack.ErrRateLimited},
+		{code: "missing_scope", want: slack.ErrMissingScope},
+		{code: "internal_error", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.code, func(t *testing.T) {
+				var err error = &slack.ErrorResponse{Error_: tt.code}
+
+				for _, sentinel := range sentinels {
+					if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+						t.Errorf("errors.Is(%s, %v) = %t", tt.code, sentinel, got)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestErrorResponseError(t *testing.T) {
+	err := &slack.ErrorResponse{Error_: "missing_scope", Needed: "app_configurations:write", Provided: "identify"}
+
+	if got, want := err.Error(), "missing_scope (needed: app_configurations:write, provided: identify)"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestClientReturnsTypedErrors(t *testing.T) {
+	server := slacktest.NewServer()
+	defer server.Close()
+
+	server.AddToken("xoxe.xoxp-test", time.Now().Add(time.Hour))
+
+	client := slack.NewClient("xoxe.xoxp-test").WithBaseURL(server.BaseURL()).WithMaxRetries(0)
+
+	server.Inject(
+		"apps.manifest.create",
+		slacktest.InvalidManifest(slacktest.ErrorDetail{Message: "must be shorter", Pointer: "/display_information/name"}),
+	)
+
+	_, err := client.AppsManifestCreate(context.Background(), slack.AppsManifestCreateRequest{Manifest: `{}`})
+	if !errors.Is(err, slack.ErrInvalidManifest) {
+		t.Fatalf("the error is %v, want %v", err, slack.ErrInvalidManifest)
+	}
+
+	var errorResponse *slack.ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("the error %v is not an ErrorResponse", err)
+	}
+
+	if len(errorResponse.Errors) != 1 || errorResponse.Errors[0].Pointer != "/display_information/name" {
+		t.Errorf("the error details are %+v", errorResponse.Errors)
+	}
+
+	server.Inject("apps.manifest.export", slacktest.RateLimited(0))
+
+	_, err = client.AppsManifestExport(context.Background(), slack.AppsManifestExportRequest{AppID: "A1"})
+	if !errors.Is(err, slack.ErrRateLimited) {
+		t.Fatalf("the error is %v, want %v", err, slack.ErrRateLimited)
+	}
+
+	if !errors.As(err, &errorResponse) || errorResponse.HTTPStatus != http.StatusTooManyRequests {
+		t.Errorf("the error %v does not carry the HTTP status %d", err, http.StatusTooManyRequests)
+	}
+}
@@ -0,0 +1,107 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Difference is a field whose value differs between two manifests. Before or After is nil if the field is absent.
+type Difference struct {
+	Pointer string
+	Before  any
+	After   any
+}
+
+func formatDifferenceValue(value any) string {
+	if value == nil {
+		return "(absent)"
+	}
+
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(bytes)
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("`%s`: %s -> %s", d.Pointer, formatDifferenceValue(d.Before), formatDifferenceValue(d.After))
+}
+
+func escapePointerSegment(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
+
+func diffTree(pointer string, before, after any) []Difference {
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]any)
+	afterMap, afterIsMap := after.(map[string]any)
+
+	if beforeIsMap && afterIsMap {
+		keys := make([]string, 0, len(beforeMap)+len(afterMap))
+		for key := range beforeMap {
+			keys = append(keys, key)
+		}
+
+		for key := range afterMap {
+			if _, ok := beforeMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		var differences []Difference
+		for _, key := range keys {
+			differences = append(
+				differences,
+				diffTree(pointer+"/"+escapePointerSegment(key), beforeMap[key], afterMap[key])...,
+			)
+		}
+
+		return differences
+	}
+
+	beforeSlice, beforeIsSlice := before.([]any)
+	afterSlice, afterIsSlice := after.([]any)
+
+	// Lists of the same length are compared element by element, such as slash commands edited in place.
+	if beforeIsSlice && afterIsSlice && len(beforeSlice) == len(afterSlice) {
+		var differences []Difference
+		for i := range beforeSlice {
+			differences = append(differences, diffTree(pointer+"/"+strconv.Itoa(i), beforeSlice[i], afterSlice[i])...)
+		}
+
+		return differences
+	}
+
+	if pointer == "" {
+		pointer = "/"
+	}
+
+	return []Difference{{Pointer: pointer, Before: before, After: after}}
+}
+
+// Diff lists the fields that differ between the two manifests, each written in either JSON or YAML, ignoring
+// differences that SemanticallyEqual ignores.
+func Diff(a, b string) ([]Difference, error) {
+	treeA, err := normalizedTree(a)
+	if err != nil {
+		return nil, err
+	}
+
+	treeB, err := normalizedTree(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffTree("", treeA, treeB), nil
+}
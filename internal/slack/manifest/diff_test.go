@@ -0,0 +1,113 @@
+package manifest_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want []manifest.Difference
+	}{
+		{
+			name: "semantically equal",
+			a:    `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write","commands"]}}}`,
+			b:    `{"oauth_config":{"scopes":{"bot":["commands","chat:write"]}},"display_information":{"name":"app"},"settings":{"socket_mode_enabled":false}}`,
+			want: nil,
+		},
+		{
+			name: "nested field",
+			a:    `{"display_information":{"name":"app","description":"before"}}`,
+			b:    `{"display_information":{"name":"app","description":"after"}}`,
+			want: []manifest.Difference{{Pointer: "/display_information/description", Before: "before", After: "after"}},
+		},
+		{
+			name: "added and removed fields",
+			a:    `{"display_information":{"name":"app","description":"an app"}}`,
+			b:    `{"display_information":{"name":"app"},"settings":{"socket_mode_enabled":true}}`,
+			want: []manifest.Difference{
+				{Pointer: "/display_information/description", Before: "an app", After: nil},
+				{Pointer: "/settings", Before: nil, After: map[string]any{"socket_mode_enabled": true}},
+			},
+		},
+		{
+			name: "array element edited in place",
+			a:    `{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/a","description":"a"},{"command":"/b","description":"b"}]}}`,
+			b:    `{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/a","description":"a"},{"command":"/b","description":"B"}]}}`,
+			want: []manifest.Difference{{Pointer: "/features/slash_commands/1/description", Before: "b", After: "B"}},
+		},
+		{
+			name: "array of another length",
+			a:    `{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/a","description":"a"}]}}`,
+			b:    `{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/a","description":"a"},{"command":"/b","description":"b"}]}}`,
+			want: []manifest.Difference{
+				{
+					Pointer: "/features/slash_commands",
+					Before:  []any{map[string]any{"command": "/a", "description": "a"}},
+					After: []any{
+						map[string]any{"command": "/a", "description": "a"},
+						map[string]any{"command": "/b", "description": "b"},
+					},
+				},
+			},
+		},
+		{
+			name: "escaped keys",
+			a:    `{"display_information":{"name":"app"},"functions":{"deploy/app~v2":{"title":"Deploy"}}}`,
+			b:    `{"display_information":{"name":"app"},"functions":{"deploy/app~v2":{"title":"Deploy app"}}}`,
+			want: []manifest.Difference{{Pointer: "/functions/deploy~1app~0v2/title", Before: "Deploy", After: "Deploy app"}},
+		},
+		{
+			name: "unknown fields",
+			a:    `{"display_information":{"name":"app"},"future":{"a/b":[1,2]}}`,
+			b:    `{"display_information":{"name":"app"},"future":{"a/b":[1,3]}}`,
+			want: []manifest.Difference{{Pointer: "/future/a~1b/1", Before: float64(2), After: float64(3)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := manifest.Diff(tt.a, tt.b)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Diff() = %#v, want %#v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestDifferenceString(t *testing.T) {
+	tests := []struct {
+		difference manifest.Difference
+		want       string
+	}{
+		{
+			difference: manifest.Difference{Pointer: "/display_information/name", Before: "a", After: "b"},
+			want:       "`/display_information/name`: \"a\" -> \"b\"",
+		},
+		{
+			difference: manifest.Difference{Pointer: "/settings", Before: nil, After: map[string]any{"socket_mode_enabled": true}},
+			want:       "`/settings`: (absent) -> {\"socket_mode_enabled\":true}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.want, func(t *testing.T) {
+				if got := tt.difference.String(); got != tt.want {
+					t.Errorf("String() = %s, want %s", got, tt.want)
+				}
+			},
+		)
+	}
+}
@@ -7,6 +7,20 @@ import (
 type Metadata struct {
 	MajorVersion *int `json:"major_version,omitempty"`
 	MinorVersion *int `json:"minor_version,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (m *Metadata) UnmarshalJSON(bytes []byte) error {
+	type plain Metadata
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(m), &m.Unknown)
+}
+
+func (m Metadata) MarshalJSON() ([]byte, error) {
+	type plain Metadata
+
+	return marshalKeepingUnknown(plain(m), m.Unknown)
 }
 
 type DisplayInformation struct {
@@ -14,38 +28,221 @@ type DisplayInformation struct {
 	Description     *string `json:"description,omitempty"`
 	LongDescription *string `json:"long_description,omitempty"`
 	BackgroundColor *string `json:"background_color,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (d *DisplayInformation) UnmarshalJSON(bytes []byte) error {
+	type plain DisplayInformation
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(d), &d.Unknown)
+}
+
+func (d DisplayInformation) MarshalJSON() ([]byte, error) {
+	type plain DisplayInformation
+
+	return marshalKeepingUnknown(plain(d), d.Unknown)
+}
+
+type MetadataSubscription struct {
+	AppID     string `json:"app_id"`
+	EventType string `json:"event_type"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (m *MetadataSubscription) UnmarshalJSON(bytes []byte) error {
+	type plain MetadataSubscription
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(m), &m.Unknown)
+}
+
+func (m MetadataSubscription) MarshalJSON() ([]byte, error) {
+	type plain MetadataSubscription
+
+	return marshalKeepingUnknown(plain(m), m.Unknown)
 }
 
 type EventSubscriptions struct {
-	RequestURL *string  `json:"request_url,omitempty"`
-	BotEvents  []string `json:"bot_events,omitempty"`
-	UserEvents []string `json:"user_events,omitempty"`
+	RequestURL            *string                `json:"request_url,omitempty"`
+	BotEvents             []string               `json:"bot_events,omitempty"`
+	UserEvents            []string               `json:"user_events,omitempty"`
+	MetadataSubscriptions []MetadataSubscription `json:"metadata_subscriptions,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (e *EventSubscriptions) UnmarshalJSON(bytes []byte) error {
+	type plain EventSubscriptions
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(e), &e.Unknown)
+}
+
+func (e EventSubscriptions) MarshalJSON() ([]byte, error) {
+	type plain EventSubscriptions
+
+	return marshalKeepingUnknown(plain(e), e.Unknown)
 }
 
 type Interactivity struct {
 	IsEnabled             bool    `json:"is_enabled"`
 	RequestURL            *string `json:"request_url,omitempty"`
 	MessageMenuOptionsURL *string `json:"message_menu_options_url,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (i *Interactivity) UnmarshalJSON(bytes []byte) error {
+	type plain Interactivity
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(i), &i.Unknown)
+}
+
+func (i Interactivity) MarshalJSON() ([]byte, error) {
+	type plain Interactivity
+
+	return marshalKeepingUnknown(plain(i), i.Unknown)
+}
+
+type SiwsLinks struct {
+	InitiateURI *string `json:"initiate_uri,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (s *SiwsLinks) UnmarshalJSON(bytes []byte) error {
+	type plain SiwsLinks
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(s), &s.Unknown)
+}
+
+func (s SiwsLinks) MarshalJSON() ([]byte, error) {
+	type plain SiwsLinks
+
+	return marshalKeepingUnknown(plain(s), s.Unknown)
+}
+
+type IncomingWebhooks struct {
+	IncomingWebhooksEnabled *bool `json:"incoming_webhooks_enabled,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (i *IncomingWebhooks) UnmarshalJSON(bytes []byte) error {
+	type plain IncomingWebhooks
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(i), &i.Unknown)
+}
+
+func (i IncomingWebhooks) MarshalJSON() ([]byte, error) {
+	type plain IncomingWebhooks
+
+	return marshalKeepingUnknown(plain(i), i.Unknown)
 }
 
 type Settings struct {
 	AllowedIPAddressRanges []string            `json:"allowed_ip_address_ranges,omitempty"`
 	EventSubscriptions     *EventSubscriptions `json:"event_subscriptions,omitempty"`
+	IncomingWebhooks       *IncomingWebhooks   `json:"incoming_webhooks,omitempty"`
 	Interactivity          *Interactivity      `json:"interactivity,omitempty"`
 	OrgDeployEnabled       *bool               `json:"org_deploy_enabled,omitempty"`
 	SocketModeEnabled      *bool               `json:"socket_mode_enabled,omitempty"`
 	TokenRotationEnabled   *bool               `json:"token_rotation_enabled,omitempty"`
+	FunctionRuntime        *string             `json:"function_runtime,omitempty"`
+	IsHosted               *bool               `json:"is_hosted,omitempty"`
+	SiwsLinks              *SiwsLinks          `json:"siws_links,omitempty"`
+	HermesAppType          *string             `json:"hermes_app_type,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (s *Settings) UnmarshalJSON(bytes []byte) error {
+	type plain Settings
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(s), &s.Unknown)
+}
+
+func (s Settings) MarshalJSON() ([]byte, error) {
+	type plain Settings
+
+	return marshalKeepingUnknown(plain(s), s.Unknown)
 }
 
 type AppHome struct {
 	HomeTabEnabled             *bool `json:"home_tab_enabled,omitempty"`
 	MessagesTabEnabled         *bool `json:"messages_tab_enabled,omitempty"`
 	MessagesTabReadOnlyEnabled *bool `json:"messages_tab_read_only_enabled,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (a *AppHome) UnmarshalJSON(bytes []byte) error {
+	type plain AppHome
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(a), &a.Unknown)
+}
+
+func (a AppHome) MarshalJSON() ([]byte, error) {
+	type plain AppHome
+
+	return marshalKeepingUnknown(plain(a), a.Unknown)
 }
 
 type BotUser struct {
 	DisplayName  string `json:"display_name"`
 	AlwaysOnline *bool  `json:"always_online,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (b *BotUser) UnmarshalJSON(bytes []byte) error {
+	type plain BotUser
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(b), &b.Unknown)
+}
+
+func (b BotUser) MarshalJSON() ([]byte, error) {
+	type plain BotUser
+
+	return marshalKeepingUnknown(plain(b), b.Unknown)
+}
+
+type SuggestedPrompt struct {
+	Title   string `json:"title"`
+	Message string `json:"message"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (s *SuggestedPrompt) UnmarshalJSON(bytes []byte) error {
+	type plain SuggestedPrompt
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(s), &s.Unknown)
+}
+
+func (s SuggestedPrompt) MarshalJSON() ([]byte, error) {
+	type plain SuggestedPrompt
+
+	return marshalKeepingUnknown(plain(s), s.Unknown)
+}
+
+type AssistantView struct {
+	AssistantDescription string            `json:"assistant_description"`
+	SuggestedPrompts     []SuggestedPrompt `json:"suggested_prompts,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (a *AssistantView) UnmarshalJSON(bytes []byte) error {
+	type plain AssistantView
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(a), &a.Unknown)
+}
+
+func (a AssistantView) MarshalJSON() ([]byte, error) {
+	type plain AssistantView
+
+	return marshalKeepingUnknown(plain(a), a.Unknown)
 }
 
 type ShortcutType string
@@ -60,6 +257,25 @@ type Shortcut struct {
 	CallbackID  string       `json:"callback_id"`
 	Description string       `json:"description"`
 	Type        ShortcutType `json:"type"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (s *Shortcut) UnmarshalJSON(bytes []byte) error {
+	type plain Shortcut
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(s), &s.Unknown)
+}
+
+func (s Shortcut) MarshalJSON() ([]byte, error) {
+	type plain Shortcut
+
+	return marshalKeepingUnknown(plain(s), s.Unknown)
+}
+
+// Is reports whether both describe the same shortcut, which is identified by its callback ID.
+func (s Shortcut) Is(other Shortcut) bool {
+	return s.CallbackID == other.CallbackID
 }
 
 type SlashCommand struct {
@@ -68,38 +284,293 @@ type SlashCommand struct {
 	ShouldEscape *bool   `json:"should_escape,omitempty"`
 	URL          *string `json:"url,omitempty"`
 	UsageHint    *string `json:"usage_hint,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (s *SlashCommand) UnmarshalJSON(bytes []byte) error {
+	type plain SlashCommand
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(s), &s.Unknown)
+}
+
+func (s SlashCommand) MarshalJSON() ([]byte, error) {
+	type plain SlashCommand
+
+	return marshalKeepingUnknown(plain(s), s.Unknown)
+}
+
+// Is reports whether both describe the same slash command, which is identified by the command itself.
+func (s SlashCommand) Is(other SlashCommand) bool {
+	return s.Command == other.Command
 }
 
 type WorkflowStep struct {
 	Name       string `json:"name"`
 	CallbackID string `json:"callback_id"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (w *WorkflowStep) UnmarshalJSON(bytes []byte) error {
+	type plain WorkflowStep
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(w), &w.Unknown)
+}
+
+func (w WorkflowStep) MarshalJSON() ([]byte, error) {
+	type plain WorkflowStep
+
+	return marshalKeepingUnknown(plain(w), w.Unknown)
 }
 
 type Features struct {
 	AppHome       *AppHome       `json:"app_home,omitempty"`
+	AssistantView *AssistantView `json:"assistant_view,omitempty"`
 	BotUser       *BotUser       `json:"bot_user,omitempty"`
 	Shortcuts     []Shortcut     `json:"shortcuts,omitempty"`
 	SlashCommands []SlashCommand `json:"slash_commands,omitempty"`
 	UnfurlDomains []string       `json:"unfurl_domains,omitempty"`
 	WorkflowSteps []WorkflowStep `json:"workflow_steps,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (f *Features) UnmarshalJSON(bytes []byte) error {
+	type plain Features
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(f), &f.Unknown)
+}
+
+func (f Features) MarshalJSON() ([]byte, error) {
+	type plain Features
+
+	return marshalKeepingUnknown(plain(f), f.Unknown)
 }
 
 type Scopes struct {
 	Bot  []string `json:"bot,omitempty"`
 	User []string `json:"user,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (s *Scopes) UnmarshalJSON(bytes []byte) error {
+	type plain Scopes
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(s), &s.Unknown)
+}
+
+func (s Scopes) MarshalJSON() ([]byte, error) {
+	type plain Scopes
+
+	return marshalKeepingUnknown(plain(s), s.Unknown)
 }
 
 type OauthConfig struct {
 	RedirectURLs []string `json:"redirect_urls,omitempty"`
 	Scopes       *Scopes  `json:"scopes,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (o *OauthConfig) UnmarshalJSON(bytes []byte) error {
+	type plain OauthConfig
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(o), &o.Unknown)
+}
+
+func (o OauthConfig) MarshalJSON() ([]byte, error) {
+	type plain OauthConfig
+
+	return marshalKeepingUnknown(plain(o), o.Unknown)
+}
+
+type Parameter struct {
+	Type        string  `json:"type"`
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (p *Parameter) UnmarshalJSON(bytes []byte) error {
+	type plain Parameter
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(p), &p.Unknown)
+}
+
+func (p Parameter) MarshalJSON() ([]byte, error) {
+	type plain Parameter
+
+	return marshalKeepingUnknown(plain(p), p.Unknown)
+}
+
+type ParameterSet struct {
+	Properties map[string]Parameter `json:"properties"`
+	Required   []string             `json:"required,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (p *ParameterSet) UnmarshalJSON(bytes []byte) error {
+	type plain ParameterSet
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(p), &p.Unknown)
+}
+
+func (p ParameterSet) MarshalJSON() ([]byte, error) {
+	type plain ParameterSet
+
+	return marshalKeepingUnknown(plain(p), p.Unknown)
+}
+
+type Function struct {
+	Title            string        `json:"title"`
+	Description      *string       `json:"description,omitempty"`
+	InputParameters  *ParameterSet `json:"input_parameters,omitempty"`
+	OutputParameters *ParameterSet `json:"output_parameters,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (f *Function) UnmarshalJSON(bytes []byte) error {
+	type plain Function
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(f), &f.Unknown)
+}
+
+func (f Function) MarshalJSON() ([]byte, error) {
+	type plain Function
+
+	return marshalKeepingUnknown(plain(f), f.Unknown)
+}
+
+type Step struct {
+	ID         string                     `json:"id"`
+	FunctionID string                     `json:"function_id"`
+	Inputs     map[string]json.RawMessage `json:"inputs,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (s *Step) UnmarshalJSON(bytes []byte) error {
+	type plain Step
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(s), &s.Unknown)
+}
+
+func (s Step) MarshalJSON() ([]byte, error) {
+	type plain Step
+
+	return marshalKeepingUnknown(plain(s), s.Unknown)
+}
+
+type Workflow struct {
+	Title           string        `json:"title"`
+	Description     *string       `json:"description,omitempty"`
+	InputParameters *ParameterSet `json:"input_parameters,omitempty"`
+	Steps           []Step        `json:"steps"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (w *Workflow) UnmarshalJSON(bytes []byte) error {
+	type plain Workflow
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(w), &w.Unknown)
+}
+
+func (w Workflow) MarshalJSON() ([]byte, error) {
+	type plain Workflow
+
+	return marshalKeepingUnknown(plain(w), w.Unknown)
+}
+
+type DatastoreAttribute struct {
+	Type string `json:"type"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (d *DatastoreAttribute) UnmarshalJSON(bytes []byte) error {
+	type plain DatastoreAttribute
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(d), &d.Unknown)
+}
+
+func (d DatastoreAttribute) MarshalJSON() ([]byte, error) {
+	type plain DatastoreAttribute
+
+	return marshalKeepingUnknown(plain(d), d.Unknown)
+}
+
+type Datastore struct {
+	PrimaryKey string                        `json:"primary_key"`
+	Attributes map[string]DatastoreAttribute `json:"attributes"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (d *Datastore) UnmarshalJSON(bytes []byte) error {
+	type plain Datastore
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(d), &d.Unknown)
+}
+
+func (d Datastore) MarshalJSON() ([]byte, error) {
+	type plain Datastore
+
+	return marshalKeepingUnknown(plain(d), d.Unknown)
+}
+
+type CustomType struct {
+	Type        string               `json:"type"`
+	Title       *string              `json:"title,omitempty"`
+	Description *string              `json:"description,omitempty"`
+	Properties  map[string]Parameter `json:"properties,omitempty"`
+	Required    []string             `json:"required,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (c *CustomType) UnmarshalJSON(bytes []byte) error {
+	type plain CustomType
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(c), &c.Unknown)
+}
+
+func (c CustomType) MarshalJSON() ([]byte, error) {
+	type plain CustomType
+
+	return marshalKeepingUnknown(plain(c), c.Unknown)
 }
 
 type App struct {
-	Metadata           *Metadata          `json:"_metadata,omitempty"`
-	DisplayInformation DisplayInformation `json:"display_information"`
-	Settings           *Settings          `json:"settings,omitempty"`
-	Features           *Features          `json:"features,omitempty"`
-	OauthConfig        *OauthConfig       `json:"oauth_config,omitempty"`
+	Metadata           *Metadata             `json:"_metadata,omitempty"`
+	DisplayInformation DisplayInformation    `json:"display_information"`
+	Settings           *Settings             `json:"settings,omitempty"`
+	Features           *Features             `json:"features,omitempty"`
+	OauthConfig        *OauthConfig          `json:"oauth_config,omitempty"`
+	Functions          map[string]Function   `json:"functions,omitempty"`
+	Workflows          map[string]Workflow   `json:"workflows,omitempty"`
+	Datastores         map[string]Datastore  `json:"datastores,omitempty"`
+	Types              map[string]CustomType `json:"types,omitempty"`
+	OutgoingDomains    []string              `json:"outgoing_domains,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
+}
+
+func (m *App) UnmarshalJSON(bytes []byte) error {
+	type plain App
+
+	return unmarshalKeepingUnknown(bytes, (*plain)(m), &m.Unknown)
+}
+
+func (m App) MarshalJSON() ([]byte, error) {
+	type plain App
+
+	return marshalKeepingUnknown(plain(m), m.Unknown)
 }
 
 func (m *App) ToJsonString() (string, error) {
@@ -0,0 +1,70 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+func TestAppKeepsUnknownFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "without unknown fields",
+			source: `{"settings":{"socket_mode_enabled":true},"display_information":{"name":"app"}}`,
+			want:   `{"display_information":{"name":"app"},"settings":{"socket_mode_enabled":true}}`,
+		},
+		{
+			name: "at several levels",
+			source: `{
+  "zeta": 1,
+  "display_information": {"name": "app", "beta": {"b": 2, "a": [1, 2]}},
+  "features": {"slash_commands": [{"command": "/a", "description": "a", "icon": "x"}], "alpha": true},
+  "settings": {"event_subscriptions": {"bot_events": ["app_mention"], "filters": {"x": 1}}},
+  "alpha": "first"
+}`,
+			want: `{"display_information":{"name":"app","beta":{"b":2,"a":[1,2]}},` +
+				`"settings":{"event_subscriptions":{"bot_events":["app_mention"],"filters":{"x":1}}},` +
+				`"features":{"slash_commands":[{"command":"/a","description":"a","icon":"x"}],"alpha":true},` +
+				`"alpha":"first","zeta":1}`,
+		},
+		{
+			name:   "unknown fields in another order",
+			source: `{"alpha":"first","zeta":1,"display_information":{"beta":{"b":2,"a":[1,2]},"name":"app"}}`,
+			want:   `{"display_information":{"name":"app","beta":{"b":2,"a":[1,2]}},"alpha":"first","zeta":1}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				app, err := manifest.Parse(tt.source)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := app.ToJsonString()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got != tt.want {
+					t.Errorf("ToJsonString() =\n%s\nwant\n%s", got, tt.want)
+				}
+
+				// The output must read back into the same manifest.
+				again, err := manifest.Parse(got)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if gotAgain, err := again.ToJsonString(); err != nil || gotAgain != got {
+					t.Errorf("the round trip changed the manifest into %s (%v)", gotAgain, err)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,214 @@
+package manifest
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+func normalizeSet(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+// normalizeFlag drops flags that are explicitly set to false, which is what Slack assumes when they are omitted.
+func normalizeFlag(flag *bool) *bool {
+	if flag == nil || !*flag {
+		return nil
+	}
+
+	return flag
+}
+
+func normalizeMetadataSubscriptions(subscriptions []MetadataSubscription) []MetadataSubscription {
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	sorted := append([]MetadataSubscription(nil), subscriptions...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].AppID != sorted[j].AppID {
+			return sorted[i].AppID < sorted[j].AppID
+		}
+
+		return sorted[i].EventType < sorted[j].EventType
+	})
+
+	return sorted
+}
+
+// normalizeFunctionParameters makes a missing parameter set of a function empty, which is how Slack exports it.
+func normalizeFunctionParameters(parameters *ParameterSet) *ParameterSet {
+	if parameters == nil {
+		return &ParameterSet{Properties: map[string]Parameter{}}
+	}
+
+	parameters.Required = normalizeSet(parameters.Required)
+
+	return parameters
+}
+
+func omitZero[T any](value *T) *T {
+	if value == nil || reflect.ValueOf(*value).IsZero() {
+		return nil
+	}
+
+	return value
+}
+
+// Normalize rewrites the manifest in place into a canonical form, so that two manifests describing the same app
+// serialise identically regardless of the ordering of set-like arrays or omitted defaults.
+func (m *App) Normalize() {
+	if s := m.Settings; s != nil {
+		s.AllowedIPAddressRanges = normalizeSet(s.AllowedIPAddressRanges)
+		s.OrgDeployEnabled = normalizeFlag(s.OrgDeployEnabled)
+		s.SocketModeEnabled = normalizeFlag(s.SocketModeEnabled)
+		s.TokenRotationEnabled = normalizeFlag(s.TokenRotationEnabled)
+		s.IsHosted = normalizeFlag(s.IsHosted)
+
+		if e := s.EventSubscriptions; e != nil {
+			e.BotEvents = normalizeSet(e.BotEvents)
+			e.UserEvents = normalizeSet(e.UserEvents)
+			e.MetadataSubscriptions = normalizeMetadataSubscriptions(e.MetadataSubscriptions)
+		}
+
+		if w := s.IncomingWebhooks; w != nil {
+			w.IncomingWebhooksEnabled = normalizeFlag(w.IncomingWebhooksEnabled)
+			s.IncomingWebhooks = omitZero(w)
+		}
+
+		s.SiwsLinks = omitZero(s.SiwsLinks)
+		s.Interactivity = omitZero(s.Interactivity)
+		s.EventSubscriptions = omitZero(s.EventSubscriptions)
+		m.Settings = omitZero(s)
+	}
+
+	if f := m.Features; f != nil {
+		f.UnfurlDomains = normalizeSet(f.UnfurlDomains)
+
+		if h := f.AppHome; h != nil {
+			h.HomeTabEnabled = normalizeFlag(h.HomeTabEnabled)
+			h.MessagesTabEnabled = normalizeFlag(h.MessagesTabEnabled)
+			h.MessagesTabReadOnlyEnabled = normalizeFlag(h.MessagesTabReadOnlyEnabled)
+			f.AppHome = omitZero(h)
+		}
+
+		if v := f.AssistantView; v != nil && len(v.SuggestedPrompts) == 0 {
+			v.SuggestedPrompts = nil
+		}
+
+		if u := f.BotUser; u != nil {
+			u.AlwaysOnline = normalizeFlag(u.AlwaysOnline)
+		}
+
+		for i := range f.SlashCommands {
+			f.SlashCommands[i].ShouldEscape = normalizeFlag(f.SlashCommands[i].ShouldEscape)
+		}
+
+		if len(f.Shortcuts) == 0 {
+			f.Shortcuts = nil
+		}
+
+		if len(f.SlashCommands) == 0 {
+			f.SlashCommands = nil
+		}
+
+		if len(f.WorkflowSteps) == 0 {
+			f.WorkflowSteps = nil
+		}
+
+		m.Features = omitZero(f)
+	}
+
+	if c := m.OauthConfig; c != nil {
+		c.RedirectURLs = normalizeSet(c.RedirectURLs)
+
+		if s := c.Scopes; s != nil {
+			s.Bot = normalizeSet(s.Bot)
+			s.User = normalizeSet(s.User)
+			c.Scopes = omitZero(s)
+		}
+
+		m.OauthConfig = omitZero(c)
+	}
+
+	for name, function := range m.Functions {
+		function.InputParameters = normalizeFunctionParameters(function.InputParameters)
+		function.OutputParameters = normalizeFunctionParameters(function.OutputParameters)
+		m.Functions[name] = function
+	}
+
+	for _, workflow := range m.Workflows {
+		if workflow.InputParameters != nil {
+			workflow.InputParameters.Required = normalizeSet(workflow.InputParameters.Required)
+		}
+	}
+
+	for name, customType := range m.Types {
+		customType.Required = normalizeSet(customType.Required)
+		m.Types[name] = customType
+	}
+
+	if len(m.Functions) == 0 {
+		m.Functions = nil
+	}
+
+	if len(m.Workflows) == 0 {
+		m.Workflows = nil
+	}
+
+	if len(m.Datastores) == 0 {
+		m.Datastores = nil
+	}
+
+	if len(m.Types) == 0 {
+		m.Types = nil
+	}
+
+	m.OutgoingDomains = normalizeSet(m.OutgoingDomains)
+
+	m.Metadata = omitZero(m.Metadata)
+}
+
+func normalizedTree(source string) (any, error) {
+	app, err := Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	app.Normalize()
+
+	bytes, err := json.Marshal(app)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decoding into a generic tree makes the comparison independent of the key order in unknown fields.
+	var tree any
+	if err := json.Unmarshal(bytes, &tree); err != nil {
+		return nil, err
+	}
+
+	return tree, nil
+}
+
+// SemanticallyEqual reports whether the two manifests, each written in either JSON or YAML, describe the same app.
+func SemanticallyEqual(a, b string) (bool, error) {
+	treeA, err := normalizedTree(a)
+	if err != nil {
+		return false, err
+	}
+
+	treeB, err := normalizedTree(b)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(treeA, treeB), nil
+}
@@ -0,0 +1,110 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+func TestSemanticallyEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{
+			name: "key order",
+			a:    `{"display_information":{"name":"app","description":"an app"},"settings":{"socket_mode_enabled":true}}`,
+			b:    `{"settings":{"socket_mode_enabled":true},"display_information":{"description":"an app","name":"app"}}`,
+			want: true,
+		},
+		{
+			name: "order of set-like arrays",
+			a:    `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["chat:write","commands"]}}}`,
+			b:    `{"display_information":{"name":"app"},"oauth_config":{"scopes":{"bot":["commands","chat:write"]}}}`,
+			want: true,
+		},
+		{
+			name: "omitted and explicit false",
+			a:    `{"display_information":{"name":"app"}}`,
+			b:    `{"display_information":{"name":"app"},"settings":{"org_deploy_enabled":false,"socket_mode_enabled":false}}`,
+			want: true,
+		},
+		{
+			name: "omitted and explicit empty arrays",
+			a:    `{"display_information":{"name":"app"}}`,
+			b:    `{"display_information":{"name":"app"},"features":{"slash_commands":[],"shortcuts":[]}}`,
+			want: true,
+		},
+		{
+			name: "missing and empty parameter sets of functions",
+			a:    `{"display_information":{"name":"app"},"functions":{"noop":{"title":"Noop"}}}`,
+			b:    `{"display_information":{"name":"app"},"functions":{"noop":{"title":"Noop","input_parameters":{"properties":{}},"output_parameters":{"properties":{}}}}}`,
+			want: true,
+		},
+		{
+			name: "YAML and JSON",
+			a:    `{"display_information":{"name":"app"},"features":{"bot_user":{"display_name":"bot","always_online":true}}}`,
+			b:    "display_information:\n  name: app\nfeatures:\n  bot_user:\n    display_name: bot\n    always_online: true\n",
+			want: true,
+		},
+		{
+			name: "unknown fields in a different key order",
+			a:    `{"display_information":{"name":"app"},"future":{"a":1,"b":[true,"x"]}}`,
+			b:    `{"future":{"b":[true,"x"],"a":1},"display_information":{"name":"app"}}`,
+			want: true,
+		},
+		{
+			name: "different unknown fields",
+			a:    `{"display_information":{"name":"app"},"future":{"a":1}}`,
+			b:    `{"display_information":{"name":"app"},"future":{"a":2}}`,
+			want: false,
+		},
+		{
+			name: "missing unknown field",
+			a:    `{"display_information":{"name":"app","future":true}}`,
+			b:    `{"display_information":{"name":"app"}}`,
+			want: false,
+		},
+		{
+			name: "different values",
+			a:    `{"display_information":{"name":"app"}}`,
+			b:    `{"display_information":{"name":"other"}}`,
+			want: false,
+		},
+		{
+			name: "explicit true and omitted",
+			a:    `{"display_information":{"name":"app"},"settings":{"socket_mode_enabled":true}}`,
+			b:    `{"display_information":{"name":"app"}}`,
+			want: false,
+		},
+		{
+			name: "order of ordered arrays",
+			a:    `{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/a","description":"a"},{"command":"/b","description":"b"}]}}`,
+			b:    `{"display_information":{"name":"app"},"features":{"slash_commands":[{"command":"/b","description":"b"},{"command":"/a","description":"a"}]}}`,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := manifest.SemanticallyEqual(tt.a, tt.b)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got != tt.want {
+					t.Errorf("SemanticallyEqual(%s, %s) = %t, want %t", tt.a, tt.b, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestSemanticallyEqualInvalid(t *testing.T) {
+	if _, err := manifest.SemanticallyEqual(`{"display_information":`, `{}`); err == nil {
+		t.Error("an invalid manifest was compared without an error")
+	}
+}
@@ -0,0 +1,58 @@
+package manifest
+
+import (
+	"net/url"
+	"strings"
+)
+
+const oauthAuthorizeURL = "https://slack.com/oauth/v2/authorize"
+
+// BotScopes returns the bot scopes requested by the app, which are nil if none are.
+func (m *App) BotScopes() []string {
+	if m.OauthConfig == nil || m.OauthConfig.Scopes == nil {
+		return nil
+	}
+
+	return m.OauthConfig.Scopes.Bot
+}
+
+// UserScopes returns the user scopes requested by the app, which are nil if none are.
+func (m *App) UserScopes() []string {
+	if m.OauthConfig == nil || m.OauthConfig.Scopes == nil {
+		return nil
+	}
+
+	return m.OauthConfig.Scopes.User
+}
+
+// BotEvents returns the events the app subscribes to, which are nil if none are.
+func (m *App) BotEvents() []string {
+	if m.Settings == nil || m.Settings.EventSubscriptions == nil {
+		return nil
+	}
+
+	return m.Settings.EventSubscriptions.BotEvents
+}
+
+// UserEvents returns the events the app subscribes to on behalf of users, which are nil if none are.
+func (m *App) UserEvents() []string {
+	if m.Settings == nil || m.Settings.EventSubscriptions == nil {
+		return nil
+	}
+
+	return m.Settings.EventSubscriptions.UserEvents
+}
+
+// OrgDeployEnabled reports whether the app is deployed to organizations.
+func (m *App) OrgDeployEnabled() bool {
+	return m.Settings != nil && m.Settings.OrgDeployEnabled != nil && *m.Settings.OrgDeployEnabled
+}
+
+// OauthAuthorizeURL returns the URL to install the app with the scopes it requests, in the same form as the one
+// returned by apps.manifest.create.
+func (m *App) OauthAuthorizeURL(clientID string) string {
+	return oauthAuthorizeURL +
+		"?client_id=" + url.QueryEscape(clientID) +
+		"&scope=" + strings.Join(m.BotScopes(), ",") +
+		"&user_scope=" + strings.Join(m.UserScopes(), ",")
+}
@@ -0,0 +1,104 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFields holds the raw JSON of the keys that are not modelled by a manifest struct,
+// so that they survive a round trip through the Go types.
+type UnknownFields map[string]json.RawMessage
+
+func knownFieldNames(t reflect.Type) map[string]struct{} {
+	names := make(map[string]struct{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		names[name] = struct{}{}
+	}
+
+	return names
+}
+
+func unmarshalKeepingUnknown[T any](bytes []byte, value *T, unknown *UnknownFields) error {
+	if err := json.Unmarshal(bytes, value); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &fields); err != nil {
+		return err
+	}
+
+	known := knownFieldNames(reflect.TypeOf(*value))
+	for name := range fields {
+		if _, ok := known[name]; ok {
+			delete(fields, name)
+		}
+	}
+
+	if len(fields) == 0 {
+		fields = nil
+	}
+
+	*unknown = fields
+
+	return nil
+}
+
+// marshalKeepingUnknown writes the modelled fields in the order of the struct, followed by the captured ones sorted by
+// name, so that the output does not depend on whether or in which order unknown fields were read.
+func marshalKeepingUnknown[T any](value T, unknown UnknownFields) ([]byte, error) {
+	modelled, err := json.Marshal(value)
+	if err != nil || len(unknown) == 0 {
+		return modelled, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(modelled, &fields); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(unknown))
+	for name := range unknown {
+		// Modelled fields always take precedence over the captured ones.
+		if _, ok := fields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	var buffer bytes.Buffer
+
+	buffer.Write(modelled[:len(modelled)-1])
+
+	for i, name := range names {
+		if i > 0 || len(fields) > 0 {
+			buffer.WriteByte(',')
+		}
+
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(key)
+		buffer.WriteByte(':')
+
+		if err := json.Compact(&buffer, unknown[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
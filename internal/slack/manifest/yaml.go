@@ -0,0 +1,195 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IsJSON reports whether the manifest source is written in JSON rather than YAML.
+// YAML flow mappings such as `{a: 1}` also start with a brace, so only valid JSON is treated as JSON.
+func IsJSON(source string) bool {
+	return json.Valid([]byte(source))
+}
+
+// ToJSON converts a manifest written in either JSON or YAML into JSON, which is what the Slack API accepts.
+// JSON sources are returned as is, so that pointers in errors reported by Slack still match them.
+func ToJSON(source string) (string, error) {
+	if IsJSON(source) {
+		return source, nil
+	}
+
+	var tree any
+	if err := yaml.Unmarshal([]byte(source), &tree); err != nil {
+		// A source that is neither valid JSON nor valid YAML is most likely broken JSON if it looks like JSON.
+		if strings.HasPrefix(strings.TrimSpace(source), "{") {
+			var jsonTree any
+			if jsonErr := json.Unmarshal([]byte(source), &jsonTree); jsonErr != nil {
+				return "", fmt.Errorf("invalid JSON: %w", jsonErr)
+			}
+		}
+
+		return "", fmt.Errorf("invalid YAML: %w", err)
+	}
+
+	if _, ok := tree.(map[string]any); !ok {
+		return "", errors.New("invalid YAML: the manifest must be a mapping")
+	}
+
+	bytes, err := json.Marshal(tree)
+	if err != nil {
+		return "", fmt.Errorf("invalid YAML: %w", err)
+	}
+
+	return string(bytes), nil
+}
+
+// Parse reads a manifest written in either JSON or YAML.
+func Parse(source string) (*App, error) {
+	manifestJSON, err := ToJSON(source)
+	if err != nil {
+		return nil, err
+	}
+
+	var app App
+	if err := json.Unmarshal([]byte(manifestJSON), &app); err != nil {
+		return nil, err
+	}
+
+	return &app, nil
+}
+
+// JSONToYAML converts a JSON manifest into YAML, keeping the order of the keys.
+func JSONToYAML(manifestJSON string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(manifestJSON))
+	decoder.UseNumber()
+
+	node, err := yamlNodeFromJSON(decoder)
+	if err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(node); err != nil {
+		return "", err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+func yamlNodeFromJSON(decoder *json.Decoder) (*yaml.Node, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := token.(type) {
+	case json.Delim:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if value == '{' {
+			node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+
+		for decoder.More() {
+			if node.Kind == yaml.MappingNode {
+				token, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+
+				key, ok := token.(string)
+				if !ok {
+					return nil, fmt.Errorf("unexpected JSON token %v for a key", token)
+				}
+
+				node.Content = append(node.Content, stringNode(key))
+			}
+
+			child, err := yamlNodeFromJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+
+			node.Content = append(node.Content, child)
+		}
+
+		// Consume the closing delimiter.
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+
+		return node, nil
+	case string:
+		return stringNode(value), nil
+	case json.Number:
+		if strings.ContainsAny(value.String(), ".eE") {
+			return scalarNode("!!float", value.String()), nil
+		}
+
+		return scalarNode("!!int", value.String()), nil
+	case bool:
+		return scalarNode("!!bool", strconv.FormatBool(value)), nil
+	case nil:
+		return scalarNode("!!null", "null"), nil
+	default:
+		return nil, fmt.Errorf("unexpected JSON token %v", token)
+	}
+}
+
+func scalarNode(tag string, value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+}
+
+// yaml11Keywords are the plain scalars that YAML 1.1 parsers resolve to booleans or null, although YAML 1.2 does not.
+var yaml11Keywords = map[string]struct{}{
+	"y": {}, "yes": {}, "n": {}, "no": {}, "on": {}, "off": {}, "true": {}, "false": {}, "null": {}, "~": {},
+}
+
+// yaml11Sexagesimal matches the base 60 numbers of YAML 1.1, such as `1:30`.
+var yaml11Sexagesimal = regexp.MustCompile(`^[-+]?[0-9][0-9_]*(:[0-5]?[0-9])+(\.[0-9_]*)?$`)
+
+// stringNode returns a node of the string, quoted if any YAML parser could read it as something else.
+func stringNode(value string) *yaml.Node {
+	node := scalarNode("!!str", value)
+
+	if isAmbiguousScalar(value) {
+		node.Style = yaml.DoubleQuotedStyle
+	}
+
+	return node
+}
+
+func isAmbiguousScalar(value string) bool {
+	if value == "" {
+		return true
+	}
+
+	if _, ok := yaml11Keywords[strings.ToLower(value)]; ok {
+		return true
+	}
+
+	number := strings.ReplaceAll(value, "_", "")
+	if _, err := strconv.ParseFloat(number, 64); err == nil {
+		return true
+	}
+
+	if _, err := strconv.ParseInt(number, 0, 64); err == nil {
+		return true
+	}
+
+	return yaml11Sexagesimal.MatchString(value)
+}
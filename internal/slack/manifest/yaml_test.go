@@ -0,0 +1,134 @@
+package manifest_test
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/manifest"
+)
+
+func TestIsJSON(t *testing.T) {
+	tests := []struct {
+		source string
+		want   bool
+	}{
+		{source: `{"display_information":{"name":"app"}}`, want: true},
+		{source: "  {\"display_information\": {\"name\": \"app\"}}\n", want: true},
+		{source: `{display_information: {name: app}}`, want: false},
+		{source: "display_information:\n  name: app\n", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.source, func(t *testing.T) {
+				if got := manifest.IsJSON(tt.source); got != tt.want {
+					t.Errorf("IsJSON(%q) = %t, want %t", tt.source, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "JSON",
+			source: `{ "display_information": { "name": "app" } }`,
+			want:   `{ "display_information": { "name": "app" } }`,
+		},
+		{
+			name:   "YAML",
+			source: "display_information:\n  name: app\n",
+			want:   `{"display_information":{"name":"app"}}`,
+		},
+		{
+			name:   "YAML flow mapping",
+			source: `{display_information: {name: app}, settings: {socket_mode_enabled: true}}`,
+			want:   `{"display_information":{"name":"app"},"settings":{"socket_mode_enabled":true}}`,
+		},
+		{
+			name:    "broken JSON",
+			source:  `{"display_information": {"name": "app"}`,
+			wantErr: "invalid JSON",
+		},
+		{
+			name:    "YAML sequence",
+			source:  "- app\n",
+			wantErr: "must be a mapping",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := manifest.ToJSON(tt.source)
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Fatalf("ToJSON(%q) returned the error %v, want %q", tt.source, err, tt.wantErr)
+					}
+
+					return
+				}
+
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got != tt.want {
+					t.Errorf("ToJSON(%q) = %s, want %s", tt.source, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestJSONToYAMLQuotesAmbiguousStrings(t *testing.T) {
+	values := []string{"yes", "No", "on", "OFF", "y", "n", "true", "null", "~", "", "123", "1_000", "0x1F", "1.5e3", "1:30"}
+
+	for _, value := range values {
+		t.Run(
+			value, func(t *testing.T) {
+				manifestJSON := `{"display_information":{"name":"` + value + `"},"` + value + `":"key"}`
+
+				manifestYAML, err := manifest.JSONToYAML(manifestJSON)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !strings.Contains(manifestYAML, `name: "`+value+`"`) {
+					t.Errorf("the value %q is not quoted:\n%s", value, manifestYAML)
+				}
+
+				var tree map[string]any
+				if err := yaml.Unmarshal([]byte(manifestYAML), &tree); err != nil {
+					t.Fatal(err)
+				}
+
+				if got, ok := tree[value].(string); !ok || got != "key" {
+					t.Errorf("the key %q did not survive the conversion:\n%s", value, manifestYAML)
+				}
+			},
+		)
+	}
+}
+
+func TestJSONToYAMLKeepsPlainStrings(t *testing.T) {
+	manifestYAML, err := manifest.JSONToYAML(
+		`{"display_information":{"name":"app"},"settings":{"socket_mode_enabled":true,"function_runtime":"remote"},"_metadata":{"major_version":1}}`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "display_information:\n  name: app\nsettings:\n  socket_mode_enabled: true\n  function_runtime: remote\n_metadata:\n  major_version: 1\n"
+	if manifestYAML != want {
+		t.Errorf("JSONToYAML() =\n%s\nwant\n%s", manifestYAML, want)
+	}
+}
@@ -41,12 +41,8 @@ func (c *Client) AppsManifestCreate(
 		return nil, err
 	}
 
-	httpResponse, err := c.httpClient.Do(httpRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	return readJSONResponse[AppsManifestCreateResponse](ctx, httpResponse)
+	// Retrying after a lost response would create a duplicate app.
+	return sendRequest[AppsManifestCreateResponse](ctx, c, httpRequest, retryRateLimited)
 }
 
 type AppsManifestUpdateRequest struct {
@@ -77,12 +73,36 @@ func (c *Client) AppsManifestUpdate(
 		return nil, err
 	}
 
-	httpResponse, err := c.httpClient.Do(httpRequest)
+	return sendRequest[AppsManifestUpdateResponse](ctx, c, httpRequest, retryAll)
+}
+
+type AppsManifestValidateRequest struct {
+	AppID    *string `json:"app_id,omitempty"`
+	Manifest string  `json:"manifest"`
+}
+
+type AppsManifestValidateResponse struct {
+	Ok bool `json:"ok"`
+}
+
+func (r AppsManifestValidateResponse) IsOk() bool {
+	return r.Ok
+}
+
+func (c *Client) AppsManifestValidate(
+	ctx context.Context,
+	request AppsManifestValidateRequest,
+) (*AppsManifestValidateResponse, error) {
+	if err := c.ensureAppConfigurationToken(ctx); err != nil {
+		return nil, err
+	}
+
+	httpRequest, err := c.createJSONRequest(ctx, http.MethodPost, "apps.manifest.validate", &request)
 	if err != nil {
 		return nil, err
 	}
 
-	return readJSONResponse[AppsManifestUpdateResponse](ctx, httpResponse)
+	return sendRequest[AppsManifestValidateResponse](ctx, c, httpRequest, retryAll)
 }
 
 type AppsManifestExportRequest struct {
@@ -111,12 +131,7 @@ func (c *Client) AppsManifestExport(
 		return nil, err
 	}
 
-	httpResponse, err := c.httpClient.Do(httpRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	return readJSONResponse[AppsManifestExportResponse](ctx, httpResponse)
+	return sendRequest[AppsManifestExportResponse](ctx, c, httpRequest, retryAll)
 }
 
 type AppsManifestDeleteRequest struct {
@@ -144,12 +159,7 @@ func (c *Client) AppsManifestDelete(
 		return nil, err
 	}
 
-	httpResponse, err := c.httpClient.Do(httpRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	return readJSONResponse[AppsManifestDeleteResponse](ctx, httpResponse)
+	return sendRequest[AppsManifestDeleteResponse](ctx, c, httpRequest, retryAll)
 }
 
 type ToolingTokensRotateResponse struct {
@@ -176,10 +186,9 @@ func (c *Client) ToolingTokensRotate(
 		return nil, err
 	}
 
-	httpResponse, err := c.httpClient.Do(httpRequest)
-	if err != nil {
-		return nil, err
-	}
+	// The refresh token is the only credential here, the app configuration token may have expired already.
+	httpRequest.Header.Del("Authorization")
 
-	return readJSONResponse[ToolingTokensRotateResponse](ctx, httpResponse)
+	// The refresh token can only be used once, so it is already consumed if the response was lost.
+	return sendRequest[ToolingTokensRotateResponse](ctx, c, httpRequest, retryRateLimited)
 }
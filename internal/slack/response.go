@@ -15,6 +15,10 @@ type Response interface {
 }
 
 func readJSONResponse[T Response](ctx context.Context, httpResponse *http.Response) (*T, error) {
+	defer func() {
+		_ = httpResponse.Body.Close()
+	}()
+
 	responseBody, err := io.ReadAll(httpResponse.Body)
 	if err != nil {
 		return nil, err
@@ -31,6 +35,8 @@ func readJSONResponse[T Response](ctx context.Context, httpResponse *http.Respon
 			return nil, err
 		}
 
+		errorResponse.HTTPStatus = httpResponse.StatusCode
+
 		tflog.Debug(ctx, fmt.Sprintf("Read JSON error response: %+v", errorResponse))
 
 		return nil, &errorResponse
@@ -0,0 +1,206 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries = 5
+	defaultMaxBackoff = 30 * time.Second
+	initialBackoff    = 500 * time.Millisecond
+)
+
+// retryPolicy tells which failures of a method are retried.
+type retryPolicy int
+
+const (
+	// retryAll retries on rate limits, server errors and network errors, for methods that are safe to repeat.
+	retryAll retryPolicy = iota
+
+	// retryRateLimited retries only requests rejected by rate limits, for methods that must not take effect twice.
+	// A server or network error may hide a response lost after the method has already taken effect.
+	retryRateLimited
+)
+
+func (c *Client) WithMaxRetries(maxRetries int) *Client {
+	c.maxRetries = maxRetries
+
+	return c
+}
+
+func (c *Client) WithMaxBackoff(maxBackoff time.Duration) *Client {
+	c.maxBackoff = maxBackoff
+
+	return c
+}
+
+// backoff returns an exponentially growing delay with jitter for the given attempt, capped at maxBackoff.
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.maxBackoff
+	if attempt < 32 && initialBackoff<<attempt < c.maxBackoff {
+		delay = initialBackoff << attempt
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	return delay/2 + rand.N(delay/2+1)
+}
+
+func retryAfter(httpResponse *http.Response) (time.Duration, bool) {
+	value := httpResponse.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at), true
+	}
+
+	return 0, false
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < duration {
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func discardResponse(httpResponse *http.Response) {
+	_, _ = io.Copy(io.Discard, httpResponse.Body)
+	_ = httpResponse.Body.Close()
+}
+
+func cloneRequest(ctx context.Context, httpRequest *http.Request) (*http.Request, error) {
+	cloned := httpRequest.Clone(ctx)
+	if httpRequest.GetBody != nil {
+		body, err := httpRequest.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		cloned.Body = body
+	}
+
+	return cloned, nil
+}
+
+// sendRequest sends the request and reads the JSON response, retrying on the failures allowed by the policy until
+// maxRetries is exhausted or the context deadline would be exceeded. A request rejected because of an expired token
+// is sent once more after rotating the token.
+func sendRequest[T Response](
+	ctx context.Context,
+	c *Client,
+	httpRequest *http.Request,
+	policy retryPolicy,
+) (*T, error) {
+	renewed := false
+
+	for attempt := 0; ; attempt++ {
+		request, err := cloneRequest(ctx, httpRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		var wait time.Duration
+
+		httpResponse, err := c.httpClient.Do(request)
+		switch {
+		case err != nil:
+			if ctx.Err() != nil || policy == retryRateLimited {
+				return nil, err
+			}
+
+			wait = c.backoff(attempt)
+
+		case httpResponse.StatusCode == http.StatusTooManyRequests:
+			discardResponse(httpResponse)
+
+			err = &ErrorResponse{Error_: "ratelimited", HTTPStatus: httpResponse.StatusCode}
+			if after, ok := retryAfter(httpResponse); ok {
+				wait = after
+			} else {
+				wait = c.backoff(attempt)
+			}
+
+		case httpResponse.StatusCode >= http.StatusInternalServerError:
+			discardResponse(httpResponse)
+
+			err = fmt.Errorf("%s responded with HTTP %s", request.URL.Path, httpResponse.Status)
+			if policy == retryRateLimited {
+				return nil, err
+			}
+
+			wait = c.backoff(attempt)
+
+		default:
+			var response *T
+
+			response, err = readJSONResponse[T](ctx, httpResponse)
+
+			authorization := request.Header.Get("Authorization")
+			tokenRejected := errors.Is(err, ErrTokenExpired) || errors.Is(err, ErrInvalidAuth)
+
+			if !renewed && authorization != "" && tokenRejected {
+				token, renewErr := c.renewAppConfigurationToken(ctx, strings.TrimPrefix(authorization, "Bearer "))
+				if errors.Is(renewErr, errNoRefreshToken) {
+					return nil, err
+				}
+
+				if renewErr != nil {
+					return nil, renewErr
+				}
+
+				renewed = true
+				httpRequest.Header.Set("Authorization", "Bearer "+token)
+
+				continue
+			}
+
+			if !errors.Is(err, ErrRateLimited) {
+				return response, err
+			}
+
+			if after, ok := retryAfter(httpResponse); ok {
+				wait = after
+			} else {
+				wait = c.backoff(attempt)
+			}
+		}
+
+		if attempt >= c.maxRetries {
+			return nil, err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Retrying %s in %s after error: %v", request.URL.Path, wait, err))
+
+		if sleepErr := sleep(ctx, wait); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
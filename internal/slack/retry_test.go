@@ -0,0 +1,390 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	okBody           = `{"ok":true}`
+	rotatedBody      = `{"ok":true,"token":"xoxe.xoxp-rotated","refresh_token":"xoxe-rotated","iat":0,"exp":4102444800}`
+	rateLimitedBody  = `{"ok":false,"error":"ratelimited"}`
+	tokenExpiredBody = `{"ok":false,"error":"token_expired"}`
+	invalidAuthBody  = `{"ok":false,"error":"invalid_auth"}`
+)
+
+// step is a response served by scriptedServer.
+type step struct {
+	status     int
+	retryAfter string
+	body       string
+}
+
+// scriptedServer serves the steps scripted for each method in order, repeating the last one once they run out.
+type scriptedServer struct {
+	*httptest.Server
+
+	mutex sync.Mutex
+	steps map[string][]step
+	calls map[string]int
+}
+
+func newScriptedServer(t *testing.T, steps map[string][]step) *scriptedServer {
+	t.Helper()
+
+	s := &scriptedServer{
+		steps: steps,
+		calls: map[string]int{},
+	}
+
+	s.Server = httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				method := strings.TrimPrefix(r.URL.Path, "/")
+
+				s.mutex.Lock()
+				scripted := s.steps[method]
+				call := s.calls[method]
+				s.calls[method]++
+				s.mutex.Unlock()
+
+				if len(scripted) == 0 {
+					http.NotFound(w, r)
+
+					return
+				}
+
+				current := scripted[min(call, len(scripted)-1)]
+				if current.retryAfter != "" {
+					w.Header().Set("Retry-After", current.retryAfter)
+				}
+
+				status := current.status
+				if status == 0 {
+					status = http.StatusOK
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				_, _ = fmt.Fprint(w, current.body)
+			},
+		),
+	)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *scriptedServer) callCount(method string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.calls[method]
+}
+
+func (s *scriptedServer) client(maxRetries int) *Client {
+	return NewClient("xoxe.xoxp-test").
+		WithBaseURL(s.URL + "/").
+		WithMaxRetries(maxRetries).
+		WithMaxBackoff(time.Millisecond)
+}
+
+func callMethod(ctx context.Context, client *Client, method string) error {
+	var err error
+
+	switch method {
+	case "apps.manifest.create":
+		_, err = client.AppsManifestCreate(ctx, AppsManifestCreateRequest{Manifest: `{}`})
+	case "apps.manifest.export":
+		_, err = client.AppsManifestExport(ctx, AppsManifestExportRequest{AppID: "A1"})
+	case "apps.manifest.update":
+		_, err = client.AppsManifestUpdate(ctx, AppsManifestUpdateRequest{AppID: "A1", Manifest: `{}`})
+	case "tooling.tokens.rotate":
+		_, err = client.ToolingTokensRotate(ctx, "xoxe-1")
+	default:
+		err = fmt.Errorf("unexpected method %s", method)
+	}
+
+	return err
+}
+
+func TestSendRequestRetries(t *testing.T) {
+	serverError := step{status: http.StatusInternalServerError, body: "internal error"}
+	tooManyRequests := step{status: http.StatusTooManyRequests, retryAfter: "0", body: rateLimitedBody}
+
+	tests := []struct {
+		name       string
+		method     string
+		steps      []step
+		maxRetries int
+		wantCalls  int
+		wantErr    error
+	}{
+		{
+			name:       "HTTP 429 is retried",
+			method:     "apps.manifest.export",
+			steps:      []step{tooManyRequests, {body: okBody}},
+			maxRetries: 5,
+			wantCalls:  2,
+		},
+		{
+			name:       "HTTP 429 without Retry-After is retried",
+			method:     "apps.manifest.export",
+			steps:      []step{{status: http.StatusTooManyRequests}, {body: okBody}},
+			maxRetries: 5,
+			wantCalls:  2,
+		},
+		{
+			name:       "ratelimited in the body is retried",
+			method:     "apps.manifest.export",
+			steps:      []step{{body: rateLimitedBody}, {body: rateLimitedBody}, {body: okBody}},
+			maxRetries: 5,
+			wantCalls:  3,
+		},
+		{
+			name:       "server errors are retried",
+			method:     "apps.manifest.update",
+			steps:      []step{serverError, {status: http.StatusBadGateway}, {body: okBody}},
+			maxRetries: 5,
+			wantCalls:  3,
+		},
+		{
+			name:       "retries are bounded",
+			method:     "apps.manifest.export",
+			steps:      []step{tooManyRequests},
+			maxRetries: 2,
+			wantCalls:  3,
+			wantErr:    ErrRateLimited,
+		},
+		{
+			name:       "no retries",
+			method:     "apps.manifest.export",
+			steps:      []step{serverError},
+			maxRetries: 0,
+			wantCalls:  1,
+		},
+		{
+			name:       "other errors are not retried",
+			method:     "apps.manifest.export",
+			steps:      []step{{body: `{"ok":false,"error":"app_not_found"}`}},
+			maxRetries: 5,
+			wantCalls:  1,
+			wantErr:    ErrAppNotFound,
+		},
+		{
+			name:       "app creation is retried when rate limited",
+			method:     "apps.manifest.create",
+			steps:      []step{tooManyRequests, {body: rateLimitedBody}, {body: okBody}},
+			maxRetries: 5,
+			wantCalls:  3,
+		},
+		{
+			name:       "app creation is not retried on server errors",
+			method:     "apps.manifest.create",
+			steps:      []step{serverError, {body: okBody}},
+			maxRetries: 5,
+			wantCalls:  1,
+		},
+		{
+			name:       "token rotation is retried when rate limited",
+			method:     "tooling.tokens.rotate",
+			steps:      []step{tooManyRequests, {body: rotatedBody}},
+			maxRetries: 5,
+			wantCalls:  2,
+		},
+		{
+			name:       "token rotation is not retried on server errors",
+			method:     "tooling.tokens.rotate",
+			steps:      []step{{status: http.StatusServiceUnavailable}, {body: rotatedBody}},
+			maxRetries: 5,
+			wantCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				server := newScriptedServer(t, map[string][]step{tt.method: tt.steps})
+
+				err := callMethod(context.Background(), server.client(tt.maxRetries), tt.method)
+
+				lastStep := tt.steps[min(tt.wantCalls, len(tt.steps))-1]
+				succeeded := lastStep.body == okBody || lastStep.body == rotatedBody
+
+				switch {
+				case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+					t.Errorf("the error is %v, want %v", err, tt.wantErr)
+				case succeeded && err != nil:
+					t.Errorf("unexpected error: %v", err)
+				case !succeeded && err == nil:
+					t.Error("the failure was not reported")
+				}
+
+				if calls := server.callCount(tt.method); calls != tt.wantCalls {
+					t.Errorf("%s was called %d times, want %d", tt.method, calls, tt.wantCalls)
+				}
+			},
+		)
+	}
+}
+
+func TestSendRequestWaitsForRetryAfter(t *testing.T) {
+	server := newScriptedServer(
+		t, map[string][]step{
+			"apps.manifest.export": {
+				{status: http.StatusTooManyRequests, retryAfter: "1", body: rateLimitedBody},
+				{body: okBody},
+			},
+		},
+	)
+
+	start := time.Now()
+
+	if err := callMethod(context.Background(), server.client(5), "apps.manifest.export"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The backoff is capped at a millisecond, so only Retry-After can explain the wait.
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want at least the 1s asked for by Retry-After", elapsed)
+	}
+}
+
+func TestSendRequestGivesUpBeforeDeadline(t *testing.T) {
+	server := newScriptedServer(
+		t, map[string][]step{
+			"apps.manifest.export": {{status: http.StatusTooManyRequests, retryAfter: "60", body: rateLimitedBody}},
+		},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+
+	err := callMethod(ctx, server.client(5), "apps.manifest.export")
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("the error is %v, want %v", err, ErrRateLimited)
+	}
+
+	if elapsed := time.Since(start); elapsed >= 5*time.Second {
+		t.Errorf("waited %s for a retry that could not finish before the deadline", elapsed)
+	}
+
+	if calls := server.callCount("apps.manifest.export"); calls != 1 {
+		t.Errorf("apps.manifest.export was called %d times, want 1", calls)
+	}
+}
+
+func TestSendRequestRenewsRejectedToken(t *testing.T) {
+	tests := []struct {
+		name            string
+		refreshToken    bool
+		steps           []step
+		wantExportCalls int
+		wantRotateCalls int
+		wantErr         error
+	}{
+		{
+			name:            "expired token",
+			refreshToken:    true,
+			steps:           []step{{body: tokenExpiredBody}, {body: okBody}},
+			wantExportCalls: 2,
+			wantRotateCalls: 1,
+		},
+		{
+			name:            "invalid token",
+			refreshToken:    true,
+			steps:           []step{{body: invalidAuthBody}, {body: okBody}},
+			wantExportCalls: 2,
+			wantRotateCalls: 1,
+		},
+		{
+			name:            "renewed only once",
+			refreshToken:    true,
+			steps:           []step{{body: tokenExpiredBody}},
+			wantExportCalls: 2,
+			wantRotateCalls: 1,
+			wantErr:         ErrTokenExpired,
+		},
+		{
+			name:            "without refresh token",
+			steps:           []step{{body: tokenExpiredBody}, {body: okBody}},
+			wantExportCalls: 1,
+			wantRotateCalls: 0,
+			wantErr:         ErrTokenExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				server := newScriptedServer(
+					t, map[string][]step{
+						"apps.manifest.export":  tt.steps,
+						"tooling.tokens.rotate": {{body: rotatedBody}},
+					},
+				)
+
+				client := server.client(5)
+				if tt.refreshToken {
+					refreshToken := "xoxe-1"
+					client.refreshToken = &refreshToken
+				}
+
+				err := callMethod(context.Background(), client, "apps.manifest.export")
+				if tt.wantErr != nil {
+					if !errors.Is(err, tt.wantErr) {
+						t.Errorf("the error is %v, want %v", err, tt.wantErr)
+					}
+				} else if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				if calls := server.callCount("apps.manifest.export"); calls != tt.wantExportCalls {
+					t.Errorf("apps.manifest.export was called %d times, want %d", calls, tt.wantExportCalls)
+				}
+
+				if calls := server.callCount("tooling.tokens.rotate"); calls != tt.wantRotateCalls {
+					t.Errorf("tooling.tokens.rotate was called %d times, want %d", calls, tt.wantRotateCalls)
+				}
+			},
+		)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	client := NewClient("xoxe.xoxp-test").WithMaxBackoff(10 * time.Second)
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: initialBackoff},
+		{attempt: 1, want: 2 * initialBackoff},
+		{attempt: 3, want: 8 * initialBackoff},
+		{attempt: 5, want: 10 * time.Second},
+		{attempt: 40, want: 10 * time.Second},
+		{attempt: 100, want: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			fmt.Sprintf("attempt %d", tt.attempt), func(t *testing.T) {
+				for i := 0; i < 100; i++ {
+					// The jitter keeps the delay between the half and the whole of the exponential delay.
+					if got := client.backoff(tt.attempt); got < tt.want/2 || got > tt.want {
+						t.Fatalf("backoff(%d) = %s, want between %s and %s", tt.attempt, got, tt.want/2, tt.want)
+					}
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,56 @@
+package slacktest
+
+import (
+	"net/http"
+	"time"
+)
+
+type ErrorDetail struct {
+	Message string `json:"message"`
+	Pointer string `json:"pointer"`
+}
+
+// Fault is an error response injected into the next call of a method.
+type Fault struct {
+	Status     int
+	Error      string
+	Errors     []ErrorDetail
+	RetryAfter time.Duration
+}
+
+func InvalidManifest(errors ...ErrorDetail) Fault {
+	return Fault{
+		Status: http.StatusOK,
+		Error:  "invalid_manifest",
+		Errors: errors,
+	}
+}
+
+func AppNotFound() Fault {
+	return Fault{
+		Status: http.StatusOK,
+		Error:  "app_not_found",
+	}
+}
+
+func RateLimited(retryAfter time.Duration) Fault {
+	return Fault{
+		Status:     http.StatusTooManyRequests,
+		Error:      "ratelimited",
+		RetryAfter: retryAfter,
+	}
+}
+
+func TokenExpired() Fault {
+	return Fault{
+		Status: http.StatusOK,
+		Error:  "token_expired",
+	}
+}
+
+func InternalError() Fault {
+	return Fault{
+		Status: http.StatusInternalServerError,
+		Error:  "internal_error",
+	}
+}
@@ -0,0 +1,534 @@
+// Package slacktest provides an in-memory fake of the Slack APIs used by the provider, served over httptest, so
+// that the provider can be exercised offline by pointing its `base_url` to the fake.
+package slacktest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultTokenLifetime = 12 * time.Hour
+
+type credentials struct {
+	ClientID          string `json:"client_id"`
+	ClientSecret      string `json:"client_secret"`
+	VerificationToken string `json:"verification_token"`
+	SigningSecret     string `json:"signing_secret"`
+}
+
+type app struct {
+	manifest    map[string]any
+	credentials credentials
+}
+
+type Server struct {
+	server *httptest.Server
+
+	// TokenLifetime is the lifetime of the app configuration tokens issued by tooling.tokens.rotate.
+	TokenLifetime time.Duration
+
+	mutex         sync.Mutex
+	apps          map[string]*app
+	nextAppID     int
+	tokens        map[string]time.Time
+	refreshTokens map[string]struct{}
+	faults        map[string][]Fault
+	calls         map[string]int
+}
+
+func NewServer() *Server {
+	s := &Server{
+		TokenLifetime: defaultTokenLifetime,
+		apps:          map[string]*app{},
+		nextAppID:     1,
+		tokens:        map[string]time.Time{},
+		refreshTokens: map[string]struct{}{},
+		faults:        map[string][]Fault{},
+		calls:         map[string]int{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/apps.manifest.create", s.authenticated(s.handleCreate))
+	mux.HandleFunc("/apps.manifest.update", s.authenticated(s.handleUpdate))
+	mux.HandleFunc("/apps.manifest.export", s.authenticated(s.handleExport))
+	mux.HandleFunc("/apps.manifest.delete", s.authenticated(s.handleDelete))
+	mux.HandleFunc("/apps.manifest.validate", s.authenticated(s.handleValidate))
+	mux.HandleFunc("/tooling.tokens.rotate", s.handleRotate)
+
+	s.server = httptest.NewServer(s.withFaults(mux))
+
+	return s
+}
+
+// BaseURL returns the URL to configure as `base_url` of the provider.
+func (s *Server) BaseURL() string {
+	return s.server.URL + "/"
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+func randomString(prefix string) string {
+	bytes := make([]byte, 12)
+	_, _ = rand.Read(bytes)
+
+	return prefix + hex.EncodeToString(bytes)
+}
+
+// AddToken registers an app configuration token that is accepted until expiresAt.
+func (s *Server) AddToken(token string, expiresAt time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.tokens[token] = expiresAt
+}
+
+// AddRefreshToken registers a refresh token that can be rotated once.
+func (s *Server) AddRefreshToken(refreshToken string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.refreshTokens[refreshToken] = struct{}{}
+}
+
+// ExpireToken makes the given app configuration token expire immediately.
+func (s *Server) ExpireToken(token string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.tokens[token]; ok {
+		s.tokens[token] = time.Now()
+	}
+}
+
+// ExpireAllTokens makes every app configuration token issued so far expire immediately.
+func (s *Server) ExpireAllTokens() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for token := range s.tokens {
+		s.tokens[token] = time.Now()
+	}
+}
+
+// Inject queues a fault to be returned by the next call of the method, e.g. `apps.manifest.create`.
+func (s *Server) Inject(method string, fault Fault) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.faults[method] = append(s.faults[method], fault)
+}
+
+// Calls returns how many times the method has been called, including the calls that failed.
+func (s *Server) Calls(method string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.calls[method]
+}
+
+// CreateApp adds an app as if it had been created outside of Terraform, and returns its ID.
+func (s *Server) CreateApp(manifestJSON string) (string, error) {
+	var manifest map[string]any
+	if err := json.Unmarshal([]byte(manifestJSON), &manifest); err != nil {
+		return "", err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.createApp(manifest), nil
+}
+
+// Manifest returns the stored manifest of the app as JSON.
+func (s *Server) Manifest(appID string) (string, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	a, ok := s.apps[appID]
+	if !ok {
+		return "", false
+	}
+
+	bytes, err := json.Marshal(a.manifest)
+	if err != nil {
+		return "", false
+	}
+
+	return string(bytes), true
+}
+
+// AppIDs returns the IDs of the apps that exist, in the order they were created.
+func (s *Server) AppIDs() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	appIDs := make([]string, 0, len(s.apps))
+	for appID := range s.apps {
+		appIDs = append(appIDs, appID)
+	}
+
+	sort.Strings(appIDs)
+
+	return appIDs
+}
+
+// DeleteApp removes the app as if it had been deleted in the Slack UI.
+func (s *Server) DeleteApp(appID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.apps, appID)
+}
+
+func (s *Server) createApp(manifest map[string]any) string {
+	appID := fmt.Sprintf("A%08d", s.nextAppID)
+	s.nextAppID++
+
+	s.apps[appID] = &app{
+		manifest: stripMetadata(manifest),
+		credentials: credentials{
+			ClientID:          strconv.Itoa(s.nextAppID) + "." + strconv.Itoa(s.nextAppID),
+			ClientSecret:      randomString(""),
+			VerificationToken: randomString(""),
+			SigningSecret:     randomString(""),
+		},
+	}
+
+	return appID
+}
+
+// stripMetadata drops _metadata, as Slack does not return it from apps.manifest.export.
+func stripMetadata(manifest map[string]any) map[string]any {
+	delete(manifest, "_metadata")
+
+	return manifest
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, fault Fault) {
+	if fault.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter.Seconds())))
+	}
+
+	body := map[string]any{
+		"ok":    false,
+		"error": fault.Error,
+	}
+	if len(fault.Errors) > 0 {
+		body["errors"] = fault.Errors
+	}
+
+	status := fault.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	writeJSON(w, status, body)
+}
+
+func (s *Server) withFaults(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			method := strings.TrimPrefix(r.URL.Path, "/")
+
+			s.mutex.Lock()
+			s.calls[method]++
+
+			var fault *Fault
+			if faults := s.faults[method]; len(faults) > 0 {
+				fault = &faults[0]
+				s.faults[method] = faults[1:]
+			}
+			s.mutex.Unlock()
+
+			if fault != nil {
+				writeError(w, *fault)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		},
+	)
+}
+
+func (s *Server) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			writeError(w, Fault{Error: "not_authed"})
+
+			return
+		}
+
+		s.mutex.Lock()
+		expiresAt, ok := s.tokens[token]
+		s.mutex.Unlock()
+
+		switch {
+		case !ok:
+			writeError(w, Fault{Error: "invalid_auth"})
+		case !time.Now().Before(expiresAt):
+			writeError(w, TokenExpired())
+		default:
+			next(w, r)
+		}
+	}
+}
+
+type request struct {
+	AppID    string `json:"app_id"`
+	Manifest string `json:"manifest"`
+}
+
+func readRequest(w http.ResponseWriter, r *http.Request) (*request, bool) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, Fault{Error: "invalid_json"})
+
+		return nil, false
+	}
+
+	return &req, true
+}
+
+// parseManifest performs a small subset of the validation done by Slack.
+func parseManifest(w http.ResponseWriter, manifestJSON string) (map[string]any, bool) {
+	var manifest map[string]any
+	if err := json.Unmarshal([]byte(manifestJSON), &manifest); err != nil {
+		writeError(w, InvalidManifest(ErrorDetail{Message: "Manifest must be a valid JSON object.", Pointer: "/"}))
+
+		return nil, false
+	}
+
+	displayInformation, _ := manifest["display_information"].(map[string]any)
+	if name, _ := displayInformation["name"].(string); name == "" {
+		writeError(
+			w,
+			InvalidManifest(
+				ErrorDetail{
+					Message: "Field must be provided",
+					Pointer: "/display_information/name",
+				},
+			),
+		)
+
+		return nil, false
+	}
+
+	return manifest, true
+}
+
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request) {
+	req, ok := readRequest(w, r)
+	if !ok {
+		return
+	}
+
+	manifest, ok := parseManifest(w, req.Manifest)
+	if !ok {
+		return
+	}
+
+	s.mutex.Lock()
+	appID := s.createApp(manifest)
+	creds := s.apps[appID].credentials
+	s.mutex.Unlock()
+
+	writeJSON(
+		w, http.StatusOK, map[string]any{
+			"ok":                  true,
+			"app_id":              appID,
+			"credentials":         creds,
+			"oauth_authorize_url": oauthAuthorizeURL(creds.ClientID, manifest),
+		},
+	)
+}
+
+func scopesOf(manifest map[string]any) any {
+	oauthConfig, _ := manifest["oauth_config"].(map[string]any)
+
+	return oauthConfig["scopes"]
+}
+
+// oauthAuthorizeURL returns the URL to install the app with the scopes it requests, in the form used by Slack.
+func oauthAuthorizeURL(clientID string, manifest map[string]any) string {
+	scopes, _ := scopesOf(manifest).(map[string]any)
+
+	joined := func(kind string) string {
+		values, _ := scopes[kind].([]any)
+
+		names := make([]string, 0, len(values))
+		for _, value := range values {
+			if name, ok := value.(string); ok {
+				names = append(names, name)
+			}
+		}
+
+		return strings.Join(names, ",")
+	}
+
+	return "https://slack.com/oauth/v2/authorize?client_id=" + url.QueryEscape(clientID) +
+		"&scope=" + joined("bot") +
+		"&user_scope=" + joined("user")
+}
+
+func (s *Server) handleUpdate(w http.ResponseWriter, r *http.Request) {
+	req, ok := readRequest(w, r)
+	if !ok {
+		return
+	}
+
+	manifest, ok := parseManifest(w, req.Manifest)
+	if !ok {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	a, ok := s.apps[req.AppID]
+	if !ok {
+		writeError(w, AppNotFound())
+
+		return
+	}
+
+	permissionsUpdated := !reflect.DeepEqual(scopesOf(a.manifest), scopesOf(manifest))
+	a.manifest = stripMetadata(manifest)
+
+	writeJSON(
+		w, http.StatusOK, map[string]any{
+			"ok":                  true,
+			"app_id":              req.AppID,
+			"permissions_updated": permissionsUpdated,
+		},
+	)
+}
+
+func (s *Server) handleExport(w http.ResponseWriter, r *http.Request) {
+	req, ok := readRequest(w, r)
+	if !ok {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	a, ok := s.apps[req.AppID]
+	if !ok {
+		writeError(w, AppNotFound())
+
+		return
+	}
+
+	writeJSON(
+		w, http.StatusOK, map[string]any{
+			"ok":       true,
+			"manifest": a.manifest,
+		},
+	)
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request) {
+	req, ok := readRequest(w, r)
+	if !ok {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.apps[req.AppID]; !ok {
+		writeError(w, AppNotFound())
+
+		return
+	}
+
+	delete(s.apps, req.AppID)
+
+	writeJSON(w, http.StatusOK, map[string]any{"ok": true})
+}
+
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	req, ok := readRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if _, ok := parseManifest(w, req.Manifest); !ok {
+		return
+	}
+
+	if req.AppID != "" {
+		s.mutex.Lock()
+		_, ok := s.apps[req.AppID]
+		s.mutex.Unlock()
+
+		if !ok {
+			writeError(w, AppNotFound())
+
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"ok": true})
+}
+
+func (s *Server) handleRotate(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, Fault{Error: "invalid_form_data"})
+
+		return
+	}
+
+	refreshToken := r.PostForm.Get("refresh_token")
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.refreshTokens[refreshToken]; !ok {
+		writeError(w, Fault{Error: "invalid_refresh_token"})
+
+		return
+	}
+
+	delete(s.refreshTokens, refreshToken)
+
+	now := time.Now()
+	expiresAt := now.Add(s.TokenLifetime)
+	token := randomString("xoxe.xoxp-")
+	newRefreshToken := randomString("xoxe-")
+
+	s.tokens[token] = expiresAt
+	s.refreshTokens[newRefreshToken] = struct{}{}
+
+	writeJSON(
+		w, http.StatusOK, map[string]any{
+			"ok":            true,
+			"token":         token,
+			"refresh_token": newRefreshToken,
+			"iat":           now.Unix(),
+			"exp":           expiresAt.Unix(),
+		},
+	)
+}
@@ -0,0 +1,36 @@
+package slack
+
+import (
+	"context"
+	"time"
+)
+
+// Token is a pair of app configuration token and refresh token issued by tooling.tokens.rotate.
+type Token struct {
+	AppConfigurationToken string    `json:"token"`
+	RefreshToken          string    `json:"refresh_token"`
+	ExpiresAt             time.Time `json:"expires_at"`
+}
+
+// TokenStore persists rotated tokens, so that the next run can resume from the latest refresh token.
+type TokenStore interface {
+	// Load returns the stored token, or nil if nothing has been stored yet.
+	Load(ctx context.Context) (*Token, error)
+	Save(ctx context.Context, token Token) error
+}
+
+// LockingTokenStore is a TokenStore that can hold its lock across loading the token and saving the rotated one, so
+// that processes sharing the store never rotate the same refresh token.
+type LockingTokenStore interface {
+	TokenStore
+
+	// Update loads the stored token, which is nil if nothing has been stored yet, and saves the token returned by
+	// update unless it is nil, without letting anyone else access the store in between.
+	Update(ctx context.Context, update func(stored *Token) (*Token, error)) error
+}
+
+func (c *Client) WithTokenStore(tokenStore TokenStore) *Client {
+	c.tokenStore = tokenStore
+
+	return c
+}
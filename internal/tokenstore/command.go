@@ -0,0 +1,76 @@
+package tokenstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+)
+
+// Command delegates the storage to an external command. The command is invoked with an additional `load` argument
+// and must print the stored token as JSON, or nothing if no token is stored. It is invoked with `save` to store a
+// token, which is passed as JSON on its standard input.
+type Command struct {
+	Command []string
+}
+
+func NewCommand(command []string) *Command {
+	return &Command{
+		Command: command,
+	}
+}
+
+func (c *Command) run(ctx context.Context, action string, stdin []byte) ([]byte, error) {
+	if len(c.Command) == 0 {
+		return nil, errors.New("token store command is empty")
+	}
+
+	args := append(append([]string(nil), c.Command[1:]...), action)
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, c.Command[0], args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s %s: %w: %s", c.Command[0], action, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func (c *Command) Load(ctx context.Context) (*slack.Token, error) {
+	output, err := c.run(ctx, "load", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bytes.TrimSpace(output)) == 0 {
+		return nil, nil
+	}
+
+	var token slack.Token
+	if err := json.Unmarshal(output, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (c *Command) Save(ctx context.Context, token slack.Token) error {
+	input, err := json.Marshal(&token)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.run(ctx, "save", input)
+
+	return err
+}
@@ -0,0 +1,164 @@
+package tokenstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+)
+
+const lockRetryInterval = 100 * time.Millisecond
+
+var (
+	// staleLockAge is how long a lock file can go untouched before it is considered to be left behind by a crashed
+	// process. Rotations retried on rate limits may hold the lock for longer, so a live lock is touched every
+	// lockRefreshInterval.
+	staleLockAge        = 5 * time.Minute
+	lockRefreshInterval = time.Minute
+)
+
+// File stores the token as JSON in a local file, guarded by a lock file next to it.
+type File struct {
+	Path string
+}
+
+func NewFile(path string) *File {
+	return &File{
+		Path: path,
+	}
+}
+
+func (f *File) lock(ctx context.Context) (func(), error) {
+	lockPath := f.Path + ".lock"
+
+	for {
+		lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			_ = lockFile.Close()
+
+			return refreshLock(lockPath), nil
+		}
+
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		// A lock left behind by a crashed process must not block every later run.
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > staleLockAge {
+			_ = os.Remove(lockPath)
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
+
+// refreshLock keeps touching the acquired lock file so that it never looks stale, and returns a function that stops
+// doing so and releases the lock.
+func refreshLock(lockPath string) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(lockRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				_ = os.Chtimes(lockPath, now, now)
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+
+		_ = os.Remove(lockPath)
+	}
+}
+
+func (f *File) Load(ctx context.Context) (*slack.Token, error) {
+	unlock, err := f.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return f.load()
+}
+
+func (f *File) Save(ctx context.Context, token slack.Token) error {
+	unlock, err := f.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return f.save(token)
+}
+
+// Update holds the lock file while the token is rotated, so that other processes wait for the rotated token instead
+// of rotating the same refresh token.
+func (f *File) Update(ctx context.Context, update func(stored *slack.Token) (*slack.Token, error)) error {
+	unlock, err := f.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	stored, err := f.load()
+	if err != nil {
+		return err
+	}
+
+	token, err := update(stored)
+	if err != nil || token == nil {
+		return err
+	}
+
+	return f.save(*token)
+}
+
+func (f *File) load() (*slack.Token, error) {
+	bytes, err := os.ReadFile(f.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var token slack.Token
+	if err := json.Unmarshal(bytes, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (f *File) save(token slack.Token) error {
+	bytes, err := json.Marshal(&token)
+	if err != nil {
+		return err
+	}
+
+	// Readers must never see a partial token.
+	return common.WriteFileAtomic(f.Path, bytes, 0o600)
+}
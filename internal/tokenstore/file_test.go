@@ -0,0 +1,68 @@
+package tokenstore_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack/slacktest"
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/tokenstore"
+)
+
+// Clients sharing a store stand for separate processes, e.g. `terraform` and the `generate` subcommand, which must
+// not rotate the same refresh token.
+func TestFileSharedBetweenClients(t *testing.T) {
+	ctx := context.Background()
+
+	server := slacktest.NewServer()
+	defer server.Close()
+
+	server.AddRefreshToken("xoxe-1")
+
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	// The stored token has expired, so every client has to rotate it first.
+	err := tokenstore.NewFile(path).Save(
+		ctx, slack.Token{AppConfigurationToken: "xoxe.xoxp-expired", RefreshToken: "xoxe-1", ExpiresAt: time.Now()},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appID, err := server.CreateApp(`{"display_information":{"name":"shared"}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		client := slack.NewClientFromTokenStore(tokenstore.NewFile(path)).WithBaseURL(server.BaseURL())
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := client.AppsManifestExport(ctx, slack.AppsManifestExportRequest{AppID: appID}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if calls := server.Calls("tooling.tokens.rotate"); calls != 1 {
+		t.Errorf("tooling.tokens.rotate was called %d times, want 1", calls)
+	}
+
+	stored, err := tokenstore.NewFile(path).Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stored == nil || stored.RefreshToken == "xoxe-1" {
+		t.Errorf("the rotated token was not stored: %+v", stored)
+	}
+}
@@ -0,0 +1,92 @@
+package tokenstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/slack"
+)
+
+func shortenLockAges(t *testing.T) {
+	t.Helper()
+
+	previousStaleLockAge, previousLockRefreshInterval := staleLockAge, lockRefreshInterval
+	staleLockAge, lockRefreshInterval = 300*time.Millisecond, 50*time.Millisecond
+
+	t.Cleanup(
+		func() {
+			staleLockAge, lockRefreshInterval = previousStaleLockAge, previousLockRefreshInterval
+		},
+	)
+}
+
+// A rotation may hold the lock for longer than staleLockAge, and another process must keep waiting for it.
+func TestLongUpdateKeepsLock(t *testing.T) {
+	shortenLockAges(t)
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	var released time.Time
+
+	started := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		err := NewFile(path).Update(
+			ctx, func(*slack.Token) (*slack.Token, error) {
+				close(started)
+				time.Sleep(4 * staleLockAge)
+				released = time.Now()
+
+				return &slack.Token{RefreshToken: "xoxe-rotated"}, nil
+			},
+		)
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+
+	<-started
+
+	if err := NewFile(path).Save(ctx, slack.Token{RefreshToken: "xoxe-other"}); err != nil {
+		t.Fatal(err)
+	}
+
+	saved := time.Now()
+
+	wg.Wait()
+
+	if saved.Before(released) {
+		t.Error("the lock held by the update was broken as stale")
+	}
+}
+
+func TestStaleLockIsBroken(t *testing.T) {
+	shortenLockAges(t)
+
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	// A lock file that nobody touches any more, as if its process had crashed.
+	if err := os.WriteFile(path+".lock", nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*staleLockAge)
+	defer cancel()
+
+	if err := NewFile(path).Save(ctx, slack.Token{RefreshToken: "xoxe-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("the lock file was not released: %v", err)
+	}
+}
@@ -9,3 +9,13 @@ func Int64PtrAsIntPtr(ptr *int64) *int {
 
 	return &value
 }
+
+func IntPtrAsInt64Ptr(ptr *int) *int64 {
+	if ptr == nil {
+		return nil
+	}
+
+	value := int64(*ptr)
+
+	return &value
+}
@@ -1,6 +1,10 @@
 package typeconv
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
 )
 
@@ -16,3 +20,31 @@ func MapListModel[T any, M common.Model[T]](model []M) []T {
 
 	return read
 }
+
+func MapList[T, U any](values []T, fn func(T) U) []U {
+	if values == nil {
+		return nil
+	}
+
+	mapped := make([]U, 0, len(values))
+	for _, value := range values {
+		mapped = append(mapped, fn(value))
+	}
+
+	return mapped
+}
+
+func MustStringListAsArray(listValue *types.List) []string {
+	elements := listValue.Elements()
+	strings := make([]string, 0, len(elements))
+	for _, element := range elements {
+		value, ok := element.(types.String)
+		if !ok {
+			panic(fmt.Sprintf("Expected types.String, got %T", element))
+		}
+
+		strings = append(strings, value.ValueString())
+	}
+
+	return strings
+}
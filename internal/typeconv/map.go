@@ -0,0 +1,41 @@
+package typeconv
+
+import (
+	"sort"
+
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/common"
+)
+
+func MapKeyedListModel[T any, M common.Model[T]](model []M, key func(M) string) map[string]T {
+	if len(model) == 0 {
+		return nil
+	}
+
+	read := make(map[string]T, len(model))
+	for _, m := range model {
+		read[key(m)] = m.Read()
+	}
+
+	return read
+}
+
+// MapKeyedList is the inverse of MapKeyedListModel, which maps the values of a map into a list ordered by their keys.
+func MapKeyedList[T, U any](values map[string]T, fn func(string, T) U) []U {
+	if len(values) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	mapped := make([]U, 0, len(values))
+	for _, key := range keys {
+		mapped = append(mapped, fn(key, values[key]))
+	}
+
+	return mapped
+}
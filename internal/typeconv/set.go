@@ -3,6 +3,7 @@ package typeconv
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,3 +21,16 @@ func MustStringSetAsArray(setValue *types.Set) []string {
 
 	return strings
 }
+
+func StringArrayAsSet(values []string) types.Set {
+	if values == nil {
+		return types.SetNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
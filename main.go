@@ -4,9 +4,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	"github.com/yumemi-inc/terraform-provider-slackapp/internal/generate"
 	"github.com/yumemi-inc/terraform-provider-slackapp/internal/provider"
 )
 
@@ -15,6 +17,14 @@ import (
 var version string = "dev"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == generate.Command {
+		if err := generate.Run(context.Background(), os.Args[2:], os.Stdout, os.Stderr); err != nil {
+			log.Fatal(err.Error())
+		}
+
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")